@@ -1,9 +1,7 @@
 package nullish
 
 import (
-	"bytes"
 	"database/sql/driver"
-	"errors"
 
 	"github.com/goccy/go-json"
 )
@@ -31,41 +29,54 @@ func (na *NullArr) Scan(value interface{}) error {
 		return nil
 	}
 
-	b, ok := value.([]interface{})
-	if !ok {
-		return errors.New("type assertion to array is failed")
-	}
+	switch t := value.(type) {
+	case []interface{}:
+		na.Arr, na.Valid = t, true
+
+	case []byte:
+		return na.decode(value, t)
+
+	case json.RawMessage:
+		return na.decode(value, t)
 
-	na.Arr, na.Valid = b, true
+	case string:
+		return na.decode(value, []byte(t))
+
+	default:
+		return unsupportedSourceError("NullArr", value)
+	}
 
 	return nil
 }
 
 // MarshalJSON method
 func (na NullArr) MarshalJSON() ([]byte, error) {
-
-	if !na.Valid {
-		return NullType, nil
-	}
-
-	return json.Marshal(na.Arr)
+	return NewNull(na.Arr, na.Valid).MarshalJSON()
 }
 
 // UnmarshalJSON method
 func (na *NullArr) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, NullType) {
-		*na = NullArr{}
-		return nil
+	var res Null[[]interface{}]
+
+	err := res.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
 
+	*na = NullArr{Arr: res.V, Valid: res.Valid}
+
+	return nil
+}
+
+func (na *NullArr) decode(value interface{}, data []byte) error {
 	var res []interface{}
 
-	err := json.Unmarshal(data, &res)
+	valid, err := decodeJSON(data, '[', &res)
 	if err != nil {
-		return err
+		return parseError("NullArr", value, err)
 	}
 
-	*na = NullArr{Arr: res, Valid: true}
+	na.Arr, na.Valid = res, valid
 
 	return nil
 }
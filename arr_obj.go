@@ -1,9 +1,7 @@
 package nullish
 
 import (
-	"bytes"
 	"database/sql/driver"
-	"errors"
 
 	"github.com/goccy/go-json"
 )
@@ -31,41 +29,54 @@ func (na *NullArrObj) Scan(value interface{}) error {
 		return nil
 	}
 
-	b, ok := value.([]map[string]interface{})
-	if !ok {
-		return errors.New("type assertion to array object is failed")
-	}
+	switch t := value.(type) {
+	case []map[string]interface{}:
+		na.ArrObj, na.Valid = t, true
+
+	case []byte:
+		return na.decode(value, t)
+
+	case json.RawMessage:
+		return na.decode(value, t)
 
-	na.ArrObj, na.Valid = b, true
+	case string:
+		return na.decode(value, []byte(t))
+
+	default:
+		return unsupportedSourceError("NullArrObj", value)
+	}
 
 	return nil
 }
 
 // MarshalJSON method
 func (na NullArrObj) MarshalJSON() ([]byte, error) {
-
-	if !na.Valid {
-		return NullType, nil
-	}
-
-	return json.Marshal(na.ArrObj)
+	return NewNull(na.ArrObj, na.Valid).MarshalJSON()
 }
 
 // UnmarshalJSON method
 func (na *NullArrObj) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, NullType) {
-		*na = NullArrObj{}
-		return nil
+	var res Null[[]map[string]interface{}]
+
+	err := res.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
 
+	*na = NullArrObj{ArrObj: res.V, Valid: res.Valid}
+
+	return nil
+}
+
+func (na *NullArrObj) decode(value interface{}, data []byte) error {
 	var res []map[string]interface{}
 
-	err := json.Unmarshal(data, &res)
+	valid, err := decodeJSON(data, '[', &res)
 	if err != nil {
-		return err
+		return parseError("NullArrObj", value, err)
 	}
 
-	*na = NullArrObj{ArrObj: res, Valid: true}
+	na.ArrObj, na.Valid = res, valid
 
 	return nil
 }
@@ -1,6 +1,8 @@
 ﻿package nullish
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/goccy/go-json"
@@ -142,6 +144,40 @@ func TestNullArrObj_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestNullArrObj_ScanJSON(t *testing.T) {
+	var na NullArrObj
+
+	// Scan JSON bytes as returned by drivers
+	err := na.Scan([]byte(`[{"key":"value"}]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(na.ArrObj) != 1 || na.ArrObj[0]["key"] != "value" || !na.Valid {
+		t.Errorf("expected [{key:value}] Valid=true, got %+v", na)
+	}
+
+	// Scan JSON string
+	err = na.Scan(`[{"a":1},{"b":2}]`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(na.ArrObj) != 2 {
+		t.Errorf("expected 2 objects, got %v", na.ArrObj)
+	}
+
+	// Scan mismatched shape
+	err = na.Scan([]byte(`{"key":"value"}`))
+	if !errors.Is(err, ErrParse) || !strings.Contains(err.Error(), "json value is not an array") {
+		t.Errorf("expected shape error, got %v", err)
+	}
+
+	// Scan array of non-objects
+	err = na.Scan([]byte(`[1,2]`))
+	if err == nil {
+		t.Error("expected error for array of numbers")
+	}
+}
+
 func BenchmarkNullArrObj_Value(b *testing.B) {
 	na := NewNullArrObj([]map[string]interface{}{{"key": "value"}}, true)
 	for i := 0; i < b.N; i++ {
@@ -1,6 +1,8 @@
 ﻿package nullish
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/goccy/go-json"
@@ -136,6 +138,40 @@ func TestNullArr_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestNullArr_ScanJSON(t *testing.T) {
+	var na NullArr
+
+	// Scan JSON bytes as returned by drivers
+	err := na.Scan([]byte(`["a",1]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(na.Arr) != 2 || na.Arr[0] != "a" || !na.Valid {
+		t.Errorf("expected [a 1] Valid=true, got %+v", na)
+	}
+
+	// Scan JSON string
+	err = na.Scan(`[true]`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(na.Arr) != 1 || na.Arr[0] != true {
+		t.Errorf("expected [true], got %v", na.Arr)
+	}
+
+	// Scan mismatched shape
+	err = na.Scan(json.RawMessage(`{"key":"value"}`))
+	if !errors.Is(err, ErrParse) || !strings.Contains(err.Error(), "json value is not an array") {
+		t.Errorf("expected shape error, got %v", err)
+	}
+
+	// Scan invalid JSON
+	err = na.Scan([]byte(`[1,`))
+	if err == nil {
+		t.Error("expected error for invalid json")
+	}
+}
+
 func BenchmarkNullArr_Value(b *testing.B) {
 	na := NewNullArr([]interface{}{"a", "b"}, true)
 	for i := 0; i < b.N; i++ {
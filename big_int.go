@@ -0,0 +1,190 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// BigIntJSONFormat is the format NullBigInt.MarshalJSON emits.
+// UnmarshalJSON accepts both formats regardless.
+var BigIntJSONFormat = NumberFormatNumber
+
+// NullBigInt is a nullable *big.Int for NUMERIC(78,0) columns, 128-bit
+// counters and other integers wider than int64.
+//
+// Scan accepts string, []byte, integers and integral floats; Value writes
+// the decimal string. JSON is written as a number or a string depending on
+// BigIntJSONFormat.
+type NullBigInt struct {
+	BigInt *big.Int
+	Valid  bool
+}
+
+// Value method
+func (nb NullBigInt) Value() (driver.Value, error) {
+
+	if !nb.Valid || nb.BigInt == nil {
+		return nil, nil
+	}
+
+	return nb.BigInt.String(), nil
+}
+
+// Scan method
+func (nb *NullBigInt) Scan(value interface{}) error {
+
+	if value == nil {
+		nb.BigInt, nb.Valid = nil, false
+		return nil
+	}
+
+	var (
+		res *big.Int
+		err error
+	)
+
+	switch t := value.(type) {
+	case string:
+		res, err = parseBigInt(t)
+
+	case []byte:
+		res, err = parseBigInt(string(t))
+
+	case uint64:
+		res = new(big.Int).SetUint64(t)
+
+	case uint:
+		res = new(big.Int).SetUint64(uint64(t))
+
+	case float64:
+		res, err = floatToBigInt(t)
+
+	case float32:
+		res, err = floatToBigInt(float64(t))
+
+	default:
+		i, ierr := toInt64(value)
+		if ierr != nil {
+			return NewScanError("NullBigInt", value, ierr)
+		}
+		res = big.NewInt(i)
+	}
+
+	if err != nil {
+		return NewScanError("NullBigInt", value, err)
+	}
+
+	nb.BigInt, nb.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nb NullBigInt) MarshalJSON() ([]byte, error) {
+
+	if !nb.Valid || nb.BigInt == nil {
+		return NullType, nil
+	}
+
+	return marshalNumber(nb.BigInt.String(), BigIntJSONFormat), nil
+}
+
+// UnmarshalJSON method
+func (nb *NullBigInt) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nb = NullBigInt{}
+		return nil
+	}
+
+	str, err := unmarshalNumber(data)
+	if err != nil {
+		return err
+	}
+
+	res, err := parseBigInt(str)
+	if err != nil {
+		return err
+	}
+
+	*nb = NullBigInt{BigInt: res, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nb NullBigInt) MarshalText() ([]byte, error) {
+
+	if !nb.Valid || nb.BigInt == nil {
+		return NullText, nil
+	}
+
+	return []byte(nb.BigInt.String()), nil
+}
+
+// UnmarshalText method
+func (nb *NullBigInt) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nb = NullBigInt{}
+		return nil
+	}
+
+	res, err := parseBigInt(string(text))
+	if err != nil {
+		return err
+	}
+
+	*nb = NullBigInt{BigInt: res, Valid: true}
+
+	return nil
+}
+
+// parseBigInt parses a base 10 integer. Decimal and exponent forms such as
+// "42.000" or "1e3" are accepted as long as they have no fraction.
+func parseBigInt(s string) (*big.Int, error) {
+	str := strings.TrimSpace(s)
+
+	if res, ok := new(big.Int).SetString(str, 10); ok {
+		return res, nil
+	}
+
+	// ParseDecimal bounds the exponent, so "1e100000000" fails with
+	// ErrOverflow instead of building a hundred-million-digit integer.
+	d, err := ParseDecimal(str)
+	if err != nil {
+		if errors.Is(err, ErrOverflow) {
+			return nil, err
+		}
+		return nil, wrapParseError(err)
+	}
+
+	return decimalToBigInt(d)
+}
+
+// decimalToBigInt returns d as an integer, or ErrPrecision if d has a
+// fraction.
+func decimalToBigInt(d Decimal) (*big.Int, error) {
+	q, r := new(big.Int).QuoRem(d.int(), pow10(d.scale), new(big.Int))
+	if r.Sign() != 0 {
+		return nil, ErrPrecision
+	}
+
+	return q, nil
+}
+
+func floatToBigInt(f float64) (*big.Int, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, ErrOverflow
+	}
+
+	if f != math.Trunc(f) {
+		return nil, ErrPrecision
+	}
+
+	res, _ := big.NewFloat(f).Int(nil)
+
+	return res, nil
+}
@@ -0,0 +1,138 @@
+package nullish
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+var twoTo100, _ = new(big.Int).SetString("1267650600228229401496703205376", 10)
+
+func TestNullBigInt_Value(t *testing.T) {
+	nb := NewNullBigInt(twoTo100, true)
+	got, err := nb.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "1267650600228229401496703205376" {
+		t.Errorf("expected decimal string, got %v", got)
+	}
+
+	for _, nb := range []NullBigInt{{}, {Valid: true}} {
+		got, err = nb.Value()
+		if err != nil || got != nil {
+			t.Errorf("expected nil, got %v err=%v", got, err)
+		}
+	}
+}
+
+func TestNullBigInt_Scan(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{"1267650600228229401496703205376", "1267650600228229401496703205376"},
+		{[]byte("-42"), "-42"},
+		{"42.000", "42"},
+		{int64(-7), "-7"},
+		{uint64(18446744073709551615), "18446744073709551615"},
+		{float64(1e20), "100000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		var nb NullBigInt
+		if err := nb.Scan(tt.value); err != nil {
+			t.Fatalf("Scan(%v): unexpected error: %v", tt.value, err)
+		}
+		if nb.BigInt.String() != tt.want || !nb.Valid {
+			t.Errorf("Scan(%v): expected %s, got %+v", tt.value, tt.want, nb)
+		}
+	}
+
+	var nb NullBigInt
+	if err := nb.Scan("12.5"); !errors.Is(err, ErrPrecision) {
+		t.Errorf("expected precision error, got %v", err)
+	}
+	if err := nb.Scan(float64(1.5)); !errors.Is(err, ErrPrecision) {
+		t.Errorf("expected precision error, got %v", err)
+	}
+	if err := nb.Scan("abc"); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+	if err := nb.Scan(true); !errors.Is(err, ErrUnsupportedSource) {
+		t.Errorf("expected unsupported source error, got %v", err)
+	}
+	if err := nb.Scan(nil); err != nil || nb.Valid || nb.BigInt != nil {
+		t.Errorf("expected reset for nil, got %+v err=%v", nb, err)
+	}
+}
+
+func TestNullBigInt_ExponentLimit(t *testing.T) {
+	var nb NullBigInt
+
+	if err := nb.Scan("1e100000000"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+	if nb.Valid {
+		t.Error("expected invalid after failed scan")
+	}
+
+	if err := json.Unmarshal([]byte(`"1e100000000"`), &nb); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+	if err := nb.UnmarshalText([]byte("1e100000000")); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+
+	if err := nb.Scan("1e-1000000000"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+
+	if err := nb.Scan("1e30"); err != nil || nb.BigInt.String() != "1"+strings.Repeat("0", 30) {
+		t.Errorf("expected 10^30, got %+v err=%v", nb, err)
+	}
+}
+
+func TestNullBigInt_JSON(t *testing.T) {
+	nb := NewNullBigInt(twoTo100, true)
+
+	data, err := json.Marshal(nb)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != "1267650600228229401496703205376" {
+		t.Errorf("expected bare number, got %s", data)
+	}
+
+	defer func(format NumberFormat) { BigIntJSONFormat = format }(BigIntJSONFormat)
+	BigIntJSONFormat = NumberFormatString
+
+	data, err = json.Marshal(nb)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `"1267650600228229401496703205376"` {
+		t.Errorf("expected quoted number, got %s", data)
+	}
+
+	for _, in := range []string{`1267650600228229401496703205376`, `"1267650600228229401496703205376"`} {
+		var decoded NullBigInt
+		if err := json.Unmarshal([]byte(in), &decoded); err != nil {
+			t.Fatalf("unmarshal %s: %v", in, err)
+		}
+		if decoded.BigInt.Cmp(twoTo100) != 0 || !decoded.Valid {
+			t.Errorf("unmarshal %s: got %+v", in, decoded)
+		}
+	}
+
+	var decoded NullBigInt
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded.Valid {
+		t.Errorf("expected Valid=false for null, got %+v err=%v", decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`1.5`), &decoded); err == nil {
+		t.Error("expected error for fractional number")
+	}
+}
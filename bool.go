@@ -4,10 +4,15 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"errors"
-
-	"github.com/goccy/go-json"
+	"strconv"
+	"strings"
 )
 
+// NullBool is a nullable bool.
+//
+// Scan accepts bool, the integers 0 and 1 as stored by MySQL TINYINT(1) and
+// SQLite, and the case-insensitive strings "t", "f", "true", "false", "yes",
+// "no", "1" and "0". UnmarshalText accepts the same strings.
 type NullBool struct {
 	Bool  bool
 	Valid bool
@@ -15,12 +20,7 @@ type NullBool struct {
 
 // Value method
 func (nb NullBool) Value() (driver.Value, error) {
-
-	if !nb.Valid {
-		return nil, nil
-	}
-
-	return nb.Bool, nil
+	return NewNull(nb.Bool, nb.Valid).Value()
 }
 
 // Scan method
@@ -31,36 +31,96 @@ func (nb *NullBool) Scan(value interface{}) error {
 		return nil
 	}
 
-	b, ok := value.(bool)
-	if !ok {
-		return errors.New("type assertion to bool is failed")
-	}
+	switch t := value.(type) {
+	case bool:
+		nb.Bool, nb.Valid = t, true
+
+	case int64:
+		return nb.scanInt(value, t)
+
+	case int:
+		return nb.scanInt(value, int64(t))
+
+	case int8:
+		return nb.scanInt(value, int64(t))
+
+	case int16:
+		return nb.scanInt(value, int64(t))
+
+	case int32:
+		return nb.scanInt(value, int64(t))
+
+	case uint8:
+		return nb.scanInt(value, int64(t))
+
+	case uint16:
+		return nb.scanInt(value, int64(t))
 
-	nb.Bool, nb.Valid = b, true
+	case uint32:
+		return nb.scanInt(value, int64(t))
+
+	case uint:
+		if t > 1 {
+			return overflowError("NullBool", value)
+		}
+		nb.Bool, nb.Valid = t == 1, true
+
+	case uint64:
+		if t > 1 {
+			return overflowError("NullBool", value)
+		}
+		nb.Bool, nb.Valid = t == 1, true
+
+	case string:
+		return nb.scanText(value, t)
+
+	case []byte:
+		return nb.scanText(value, string(t))
+
+	default:
+		return unsupportedSourceError("NullBool", value)
+	}
 
 	return nil
 }
 
 // MarshalJSON method
 func (nb NullBool) MarshalJSON() ([]byte, error) {
+	return NewNull(nb.Bool, nb.Valid).MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (nb *NullBool) UnmarshalJSON(data []byte) error {
+	var res Null[bool]
+
+	err := res.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	*nb = NullBool{Bool: res.V, Valid: res.Valid}
+
+	return nil
+}
+
+// MarshalText method
+func (nb NullBool) MarshalText() ([]byte, error) {
 
 	if !nb.Valid {
-		return NullType, nil
+		return NullText, nil
 	}
 
-	return json.Marshal(nb.Bool)
+	return []byte(strconv.FormatBool(nb.Bool)), nil
 }
 
-// UnmarshalJSON method
-func (nb *NullBool) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, NullType) {
+// UnmarshalText method
+func (nb *NullBool) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
 		*nb = NullBool{}
 		return nil
 	}
 
-	var res bool
-
-	err := json.Unmarshal(data, &res)
+	res, err := parseBool(string(text))
 	if err != nil {
 		return err
 	}
@@ -69,3 +129,36 @@ func (nb *NullBool) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+
+func (nb *NullBool) scanInt(value interface{}, i int64) error {
+	if i != 0 && i != 1 {
+		return overflowError("NullBool", value)
+	}
+
+	nb.Bool, nb.Valid = i == 1, true
+
+	return nil
+}
+
+func (nb *NullBool) scanText(value interface{}, s string) error {
+	res, err := parseBool(s)
+	if err != nil {
+		return parseError("NullBool", value, err)
+	}
+
+	nb.Bool, nb.Valid = res, true
+
+	return nil
+}
+
+// parseBool parses the textual booleans accepted by NullBool.
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "t", "true", "yes", "1":
+		return true, nil
+	case "f", "false", "no", "0":
+		return false, nil
+	}
+
+	return false, errors.New("invalid boolean " + strconv.Quote(s))
+}
@@ -1,6 +1,7 @@
 package nullish
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/goccy/go-json"
@@ -63,6 +64,67 @@ func TestNullBool_JSON(t *testing.T) {
 	}
 }
 
+func TestNullBool_ScanLenient(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  bool
+	}{
+		{"int64 one", int64(1), true},
+		{"int64 zero", int64(0), false},
+		{"uint8 one", uint8(1), true},
+		{"bytes one", []byte("1"), true},
+		{"bytes zero", []byte("0"), false},
+		{"postgres t", "t", true},
+		{"postgres f", "f", false},
+		{"upper TRUE", "TRUE", true},
+		{"mixed False", []byte("False"), false},
+		{"yes", "Yes", true},
+		{"no", "NO", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nb NullBool
+			err := nb.Scan(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if nb.Bool != tt.want || !nb.Valid {
+				t.Errorf("expected Bool=%v Valid=true, got Bool=%v Valid=%v", tt.want, nb.Bool, nb.Valid)
+			}
+		})
+	}
+}
+
+func TestNullBool_ScanInvalid(t *testing.T) {
+	var nb NullBool
+
+	err := nb.Scan(int64(2))
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error for 2, got %v", err)
+	}
+
+	err = nb.Scan(uint64(7))
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error for 7, got %v", err)
+	}
+
+	err = nb.Scan("maybe")
+	if !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error for maybe, got %v", err)
+	}
+
+	err = nb.Scan(1.0)
+	if !errors.Is(err, ErrUnsupportedSource) {
+		t.Errorf("expected unsupported source error for float, got %v", err)
+	}
+
+	if nb.Valid {
+		t.Error("expected Valid=false after failed scans")
+	}
+}
+
 func BenchmarkNullBool_Value(b *testing.B) {
 	nb := NewNullBool(true, true)
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,319 @@
+package nullish
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/goccy/go-json"
+)
+
+// The helpers below convert driver values into numbers. They return the
+// cause of a failure (ErrUnsupportedSource, ErrOverflow, ErrPrecision or an
+// error wrapping ErrParse) which callers wrap into a ScanError.
+
+// maxExactFloat is the largest magnitude up to which every integer is
+// exactly representable as a float64.
+const maxExactFloat = 1 << 53
+
+// toInt64 converts a signed, unsigned, float or textual value into an int64.
+// Floats are only accepted when they hold an integral value.
+func toInt64(value interface{}) (int64, error) {
+	switch t := value.(type) {
+	case int64:
+		return t, nil
+	case int:
+		return int64(t), nil
+	case int8:
+		return int64(t), nil
+	case int16:
+		return int64(t), nil
+	case int32:
+		return int64(t), nil
+
+	case uint8:
+		return int64(t), nil
+	case uint16:
+		return int64(t), nil
+	case uint32:
+		return int64(t), nil
+	case uint:
+		return uint64ToInt64(uint64(t))
+	case uint64:
+		return uint64ToInt64(t)
+
+	case float32:
+		return floatToInt64(float64(t))
+	case float64:
+		return floatToInt64(t)
+
+	case string:
+		return parseInt64(t)
+	case []byte:
+		return parseInt64(string(t))
+	}
+
+	return 0, ErrUnsupportedSource
+}
+
+// toFloat64 converts a float, signed, unsigned or textual value into a
+// float64. Integers are only accepted when float64 represents them exactly.
+func toFloat64(value interface{}) (float64, error) {
+	switch t := value.(type) {
+	case float64:
+		return t, nil
+	case float32:
+		return float64(t), nil
+
+	case int64:
+		return int64ToFloat(t)
+	case int:
+		return int64ToFloat(int64(t))
+	case int8:
+		return float64(t), nil
+	case int16:
+		return float64(t), nil
+	case int32:
+		return float64(t), nil
+
+	case uint8:
+		return float64(t), nil
+	case uint16:
+		return float64(t), nil
+	case uint32:
+		return float64(t), nil
+	case uint:
+		return uint64ToFloat(uint64(t))
+	case uint64:
+		return uint64ToFloat(t)
+
+	case string:
+		return parseFloat64(t)
+	case []byte:
+		return parseFloat64(string(t))
+	}
+
+	return 0, ErrUnsupportedSource
+}
+
+func uint64ToInt64(u uint64) (int64, error) {
+	if u > math.MaxInt64 {
+		return 0, ErrOverflow
+	}
+
+	return int64(u), nil
+}
+
+func floatToInt64(f float64) (int64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) {
+		return 0, ErrPrecision
+	}
+
+	// -2^63 is exact in float64 while 2^63 is the first value out of range.
+	if f < math.MinInt64 || f >= -math.MinInt64 {
+		return 0, ErrOverflow
+	}
+
+	return int64(f), nil
+}
+
+func int64ToFloat(i int64) (float64, error) {
+	if i > maxExactFloat || i < -maxExactFloat {
+		f := float64(i)
+		if f >= -math.MinInt64 || int64(f) != i {
+			return 0, ErrPrecision
+		}
+	}
+
+	return float64(i), nil
+}
+
+func uint64ToFloat(u uint64) (float64, error) {
+	if u > maxExactFloat {
+		f := float64(u)
+		if f >= math.MaxUint64 || uint64(f) != u {
+			return 0, ErrPrecision
+		}
+	}
+
+	return float64(u), nil
+}
+
+func parseInt64(s string) (int64, error) {
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return i, nil
+	}
+
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, ErrOverflow
+	}
+
+	// Decimal drivers render integral NUMERIC values like "42.00".
+	b, err := parseIntegral(s, err)
+	if err != nil {
+		return 0, err
+	}
+
+	if !b.IsInt64() {
+		return 0, ErrOverflow
+	}
+
+	return b.Int64(), nil
+}
+
+func parseFloat64(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, ErrOverflow
+		}
+
+		return 0, wrapParseError(err)
+	}
+
+	return f, nil
+}
+
+// toIntN converts value into an int64 that fits in a signed integer of the
+// given bit size.
+func toIntN(value interface{}, bits int) (int64, error) {
+	i, err := toInt64(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if bits < 64 && (i < -1<<(bits-1) || i > 1<<(bits-1)-1) {
+		return 0, ErrOverflow
+	}
+
+	return i, nil
+}
+
+// toUint64 converts an unsigned, signed, float or textual value into a
+// uint64. Negative values overflow and floats must hold an integral value.
+func toUint64(value interface{}) (uint64, error) {
+	switch t := value.(type) {
+	case uint64:
+		return t, nil
+	case uint:
+		return uint64(t), nil
+	case uint8:
+		return uint64(t), nil
+	case uint16:
+		return uint64(t), nil
+	case uint32:
+		return uint64(t), nil
+
+	case float32:
+		return floatToUint64(float64(t))
+	case float64:
+		return floatToUint64(t)
+
+	case string:
+		return parseUint64(t)
+	case []byte:
+		return parseUint64(string(t))
+	}
+
+	i, err := toInt64(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if i < 0 {
+		return 0, ErrOverflow
+	}
+
+	return uint64(i), nil
+}
+
+// toUintN converts value into a uint64 that fits in an unsigned integer of
+// the given bit size.
+func toUintN(value interface{}, bits int) (uint64, error) {
+	u, err := toUint64(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if bits < 64 && u > 1<<bits-1 {
+		return 0, ErrOverflow
+	}
+
+	return u, nil
+}
+
+func floatToUint64(f float64) (uint64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) {
+		return 0, ErrPrecision
+	}
+
+	if f < 0 || f >= math.MaxUint64 {
+		return 0, ErrOverflow
+	}
+
+	return uint64(f), nil
+}
+
+func parseUint64(s string) (uint64, error) {
+	u, err := strconv.ParseUint(s, 10, 64)
+	if err == nil {
+		return u, nil
+	}
+
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, ErrOverflow
+	}
+
+	// Negative and decimal renderings such as "-1" or "42.00".
+	b, err := parseIntegral(s, err)
+	if err != nil {
+		return 0, err
+	}
+
+	if !b.IsUint64() {
+		return 0, ErrOverflow
+	}
+
+	return b.Uint64(), nil
+}
+
+// parseIntegral parses s exactly as a decimal and returns it as an integer,
+// or ErrPrecision if it has a non-zero fraction. cause is the error of the
+// integer parse, reported if s is not a number at all.
+func parseIntegral(s string, cause error) (*big.Int, error) {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		if errors.Is(err, ErrOverflow) {
+			return nil, err
+		}
+		return nil, wrapParseError(cause)
+	}
+
+	return decimalToBigInt(d)
+}
+
+// unmarshalInt decodes a JSON number into an int64 that fits in bits.
+func unmarshalInt(data []byte, bits int) (int64, error) {
+	var n json.Number
+
+	err := json.Unmarshal(data, &n)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(n.String(), 10, bits)
+}
+
+// unmarshalUint decodes a JSON number into a uint64 that fits in bits.
+func unmarshalUint(data []byte, bits int) (uint64, error) {
+	var n json.Number
+
+	err := json.Unmarshal(data, &n)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(n.String(), 10, bits)
+}
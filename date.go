@@ -0,0 +1,231 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// Date is a civil date without a time of day or location, matching DATE
+// columns.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of t in its own location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// ParseDate parses a date in the YYYY-MM-DD form.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return DateOf(t), nil
+}
+
+// String returns d in the YYYY-MM-DD form.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsZero reports whether d is the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// In returns the time of midnight at the start of d in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns d moved by n days. Out of range days are normalized, so
+// adding 1 to 2024-02-29 gives 2024-03-01.
+func (d Date) AddDays(n int) Date {
+	return DateOf(time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC))
+}
+
+// DaysSince returns the number of days from other to d.
+func (d Date) DaysSince(other Date) int {
+	// Unix seconds rather than Sub, whose time.Duration spans only 292 years.
+	return int((d.In(time.UTC).Unix() - other.In(time.UTC).Unix()) / (24 * 60 * 60))
+}
+
+// Compare returns -1, 0 or +1 depending on whether d is before, equal to or
+// after other.
+func (d Date) Compare(other Date) int {
+	switch {
+	case d.Year != other.Year:
+		return compareInt(d.Year, other.Year)
+	case d.Month != other.Month:
+		return compareInt(int(d.Month), int(other.Month))
+	}
+
+	return compareInt(d.Day, other.Day)
+}
+
+// Before reports whether d is before other.
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// After reports whether d is after other.
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+// NullDate is a nullable Date.
+//
+// Scan accepts time.Time, taking its date in its own location, and
+// YYYY-MM-DD text as string or []byte; timestamps in TimeLayouts are
+// accepted too and truncated to their date. Value writes YYYY-MM-DD, which
+// every driver stores without a timezone shift, and JSON uses the same
+// form.
+type NullDate struct {
+	Date  Date
+	Valid bool
+}
+
+// Value method
+func (nd NullDate) Value() (driver.Value, error) {
+
+	if !nd.Valid {
+		return nil, nil
+	}
+
+	return nd.Date.String(), nil
+}
+
+// Scan method
+func (nd *NullDate) Scan(value interface{}) error {
+
+	if value == nil {
+		nd.Date, nd.Valid = Date{}, false
+		return nil
+	}
+
+	switch t := value.(type) {
+	case time.Time:
+		nd.Date, nd.Valid = DateOf(t), true
+
+	case string:
+		return nd.parse(value, t)
+
+	case []byte:
+		return nd.parse(value, string(t))
+
+	default:
+		return unsupportedSourceError("NullDate", value)
+	}
+
+	return nil
+}
+
+// MarshalJSON method
+func (nd NullDate) MarshalJSON() ([]byte, error) {
+
+	if !nd.Valid {
+		return NullType, nil
+	}
+
+	return json.Marshal(nd.Date.String())
+}
+
+// UnmarshalJSON method
+func (nd *NullDate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nd = NullDate{}
+		return nil
+	}
+
+	var res string
+
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		return err
+	}
+
+	date, err := ParseDate(res)
+	if err != nil {
+		return err
+	}
+
+	*nd = NullDate{Date: date, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nd NullDate) MarshalText() ([]byte, error) {
+
+	if !nd.Valid {
+		return NullText, nil
+	}
+
+	return []byte(nd.Date.String()), nil
+}
+
+// UnmarshalText method
+func (nd *NullDate) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nd = NullDate{}
+		return nil
+	}
+
+	res, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+
+	*nd = NullDate{Date: res, Valid: true}
+
+	return nil
+}
+
+// In returns the time of midnight at the start of the date in loc, or an
+// invalid NullTime if nd is null.
+func (nd NullDate) In(loc *time.Location) NullTime {
+	if !nd.Valid {
+		return NullTime{}
+	}
+
+	return NullTime{Time: nd.Date.In(loc), Valid: true}
+}
+
+func (nd *NullDate) parse(value interface{}, s string) error {
+	res, err := ParseDate(s)
+	if err == nil {
+		nd.Date, nd.Valid = res, true
+		return nil
+	}
+
+	// Fall back to timestamps, e.g. MySQL DATETIME or SQLite TEXT columns.
+	var nt NullTime
+	if nt.parse(value, s, TimeLocation) == nil {
+		nd.Date, nd.Valid = DateOf(nt.Time), true
+		return nil
+	}
+
+	return parseError("NullDate", value, err)
+}
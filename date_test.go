@@ -0,0 +1,135 @@
+package nullish
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+func TestDate(t *testing.T) {
+	d, err := ParseDate("2024-02-28")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d != (Date{Year: 2024, Month: time.February, Day: 28}) {
+		t.Errorf("unexpected date %+v", d)
+	}
+
+	if got := d.AddDays(2).String(); got != "2024-03-01" {
+		t.Errorf("AddDays: expected 2024-03-01, got %s", got)
+	}
+	if got := d.AddDays(-59).String(); got != "2023-12-31" {
+		t.Errorf("AddDays: expected 2023-12-31, got %s", got)
+	}
+	if got := d.AddDays(366).DaysSince(d); got != 366 {
+		t.Errorf("DaysSince: expected 366, got %d", got)
+	}
+	if got := (Date{2024, 1, 1}).DaysSince(Date{1600, 1, 1}); got != 154863 {
+		t.Errorf("DaysSince: expected 154863, got %d", got)
+	}
+	if got := (Date{1, 1, 1}).DaysSince(Date{9999, 12, 31}); got != -3652058 {
+		t.Errorf("DaysSince: expected -3652058, got %d", got)
+	}
+
+	later := d.AddDays(1)
+	if !d.Before(later) || !later.After(d) || d.Compare(d) != 0 || later.Compare(d) != 1 {
+		t.Error("unexpected comparison result")
+	}
+	if (Date{Year: 2023, Month: 12, Day: 31}).Compare(d) != -1 {
+		t.Error("expected earlier year to compare lower")
+	}
+
+	if _, err := ParseDate("2024-02-30"); err == nil {
+		t.Error("expected error for invalid day")
+	}
+}
+
+func TestNullDate_ValueScan(t *testing.T) {
+	nd := NewNullDate(Date{Year: 2024, Month: 5, Day: 1}, true)
+	got, err := nd.Value()
+	if err != nil || got != "2024-05-01" {
+		t.Errorf("expected 2024-05-01, got %v err=%v", got, err)
+	}
+
+	got, err = NullDate{}.Value()
+	if err != nil || got != nil {
+		t.Errorf("expected nil, got %v err=%v", got, err)
+	}
+
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"time in zone", time.Date(2024, 5, 1, 2, 0, 0, 0, jakarta)},
+		{"string", "2024-05-01"},
+		{"bytes", []byte("2024-05-01")},
+		{"datetime", "2024-05-01 23:59:59"},
+		{"rfc3339", "2024-05-01T00:30:00+07:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nd NullDate
+			if err := nd.Scan(tt.value); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if nd.Date.String() != "2024-05-01" || !nd.Valid {
+				t.Errorf("expected 2024-05-01, got %+v", nd)
+			}
+		})
+	}
+
+	if err := nd.Scan("tomorrow"); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+	if err := nd.Scan(int64(1)); !errors.Is(err, ErrUnsupportedSource) {
+		t.Errorf("expected unsupported source error, got %v", err)
+	}
+	if err := nd.Scan(nil); err != nil || nd.Valid {
+		t.Errorf("expected Valid=false for nil, got %+v err=%v", nd, err)
+	}
+}
+
+func TestNullDate_JSON(t *testing.T) {
+	nd := NewNullDate(Date{Year: 2024, Month: 5, Day: 1}, true)
+
+	data, err := json.Marshal(nd)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `"2024-05-01"` {
+		t.Errorf(`expected "2024-05-01", got %s`, data)
+	}
+
+	var decoded NullDate
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if decoded != nd {
+		t.Errorf("roundtrip failed: expected %+v, got %+v", nd, decoded)
+	}
+
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded.Valid {
+		t.Errorf("expected Valid=false for null, got %+v err=%v", decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`"2024-05-01T00:00:00Z"`), &decoded); err == nil {
+		t.Error("expected error for timestamp")
+	}
+}
+
+func TestNullDate_In(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	nt := NewNullDate(Date{Year: 2024, Month: 5, Day: 1}, true).In(jakarta)
+	if !nt.Valid || !nt.Time.Equal(time.Date(2024, 4, 30, 17, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected time %+v", nt)
+	}
+
+	if nt := (NullDate{}).In(jakarta); nt.Valid {
+		t.Error("expected invalid NullTime for null date")
+	}
+}
@@ -0,0 +1,530 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+// NumberFormat selects how arbitrary-precision numbers are encoded in JSON.
+type NumberFormat int
+
+const (
+	// NumberFormatNumber encodes values as bare JSON numbers.
+	NumberFormatNumber NumberFormat = iota
+
+	// NumberFormatString encodes values as quoted JSON strings, which
+	// survives JavaScript clients that parse numbers as float64.
+	NumberFormatString
+)
+
+// DecimalJSONFormat is the format NullDecimal.MarshalJSON emits.
+// UnmarshalJSON accepts both formats regardless.
+var DecimalJSONFormat = NumberFormatNumber
+
+// RoundingMode selects how Decimal.Round and Decimal.Div discard digits.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to nearest, ties away from zero.
+	RoundHalfUp RoundingMode = iota
+
+	// RoundHalfEven rounds to nearest, ties to the even neighbour.
+	RoundHalfEven
+
+	// RoundHalfDown rounds to nearest, ties toward zero.
+	RoundHalfDown
+
+	// RoundDown rounds toward zero.
+	RoundDown
+
+	// RoundUp rounds away from zero.
+	RoundUp
+
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+)
+
+var bigTen = big.NewInt(10)
+
+// ParseDecimal rejects values beyond the limits of PostgreSQL NUMERIC, so
+// that untrusted input cannot build numbers of millions of digits.
+const (
+	// maxDecimalScale is the maximum number of digits after the point.
+	maxDecimalScale = 16383
+
+	// maxDecimalIntDigits is the maximum number of digits before the
+	// point, and the maximum absolute exponent.
+	maxDecimalIntDigits = 131072
+)
+
+// Decimal is an immutable arbitrary-precision decimal number equal to
+// unscaled × 10^-scale. The zero value is 0.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// NewDecimal returns unscaled × 10^-scale, e.g. NewDecimal(1230, 2) is 12.30.
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	return NewDecimalFromBigInt(big.NewInt(unscaled), scale)
+}
+
+// NewDecimalFromBigInt returns unscaled × 10^-scale. A negative scale
+// multiplies unscaled by the matching power of ten.
+func NewDecimalFromBigInt(unscaled *big.Int, scale int32) Decimal {
+	i := new(big.Int).Set(unscaled)
+
+	if scale < 0 {
+		i.Mul(i, pow10(-scale))
+		scale = 0
+	}
+
+	return Decimal{unscaled: i, scale: scale}
+}
+
+// ParseDecimal parses a decimal in plain or exponent notation such as
+// "-12.30" or "1.5e3". NaN and infinities are rejected, and values with more
+// than 131072 digits before the point or 16383 after it, the limits of
+// PostgreSQL NUMERIC, fail with ErrOverflow.
+func ParseDecimal(s string) (Decimal, error) {
+	str := strings.TrimSpace(s)
+
+	mantissa, exponent := str, int64(0)
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		exp, err := strconv.ParseInt(str[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, errors.New("invalid decimal " + strconv.Quote(s))
+		}
+		if exp > maxDecimalIntDigits || exp < -maxDecimalIntDigits {
+			return Decimal{}, fmt.Errorf("%w: decimal exponent %d", ErrOverflow, exp)
+		}
+		mantissa, exponent = str[:i], exp
+	}
+
+	sign := ""
+	if mantissa != "" && (mantissa[0] == '-' || mantissa[0] == '+') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+
+	if digits == "" || strings.TrimLeft(digits, "0123456789") != "" {
+		return Decimal{}, errors.New("invalid decimal " + strconv.Quote(s))
+	}
+
+	scale := int64(len(fracPart)) - exponent
+	if scale > maxDecimalScale {
+		return Decimal{}, fmt.Errorf("%w: decimal scale %d", ErrOverflow, scale)
+	}
+	if intDigits := int64(len(strings.TrimLeft(intPart, "0"))) + exponent; intDigits > maxDecimalIntDigits {
+		return Decimal{}, fmt.Errorf("%w: decimal with %d integer digits", ErrOverflow, intDigits)
+	}
+
+	unscaled, _ := new(big.Int).SetString(sign+digits, 10)
+
+	return NewDecimalFromBigInt(unscaled, int32(scale)), nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics on error.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// NewDecimalFromFloat returns the shortest decimal that round-trips to f.
+func NewDecimalFromFloat(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, errors.New("cannot convert " + strconv.FormatFloat(f, 'g', -1, 64) + " to decimal")
+	}
+
+	return ParseDecimal(strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+
+	return d.unscaled
+}
+
+// Unscaled returns a copy of the unscaled integer value.
+func (d Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(d.int())
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero reports whether d is zero.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// String returns d in plain notation keeping its scale, e.g. "12.30".
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+
+	if d.scale > 0 {
+		if pad := int(d.scale) - len(digits) + 1; pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		digits = digits[:len(digits)-int(d.scale)] + "." + digits[len(digits)-int(d.scale):]
+	} else if d.scale < 0 && digits != "0" {
+		digits += strings.Repeat("0", int(-d.scale))
+	}
+
+	if d.Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+// Float64 returns the nearest float64 and whether it is exact.
+func (d Decimal) Float64() (float64, bool) {
+	f, err := strconv.ParseFloat(d.String(), 64)
+	if err != nil {
+		return f, false
+	}
+
+	back, err := NewDecimalFromFloat(f)
+	if err != nil {
+		return f, false
+	}
+
+	return f, back.Cmp(d) == 0
+}
+
+// rescale returns the unscaled values of a and b brought to the larger of
+// their scales.
+func rescale(a, b Decimal) (*big.Int, *big.Int, int32) {
+	x, y := a.int(), b.int()
+
+	switch {
+	case a.scale < b.scale:
+		return new(big.Int).Mul(x, pow10(b.scale-a.scale)), y, b.scale
+	case a.scale > b.scale:
+		return x, new(big.Int).Mul(y, pow10(a.scale-b.scale)), a.scale
+	}
+
+	return x, y, a.scale
+}
+
+// Cmp compares d and other and returns -1, 0 or +1. Scale is ignored, so
+// 1.5 and 1.50 compare equal.
+func (d Decimal) Cmp(other Decimal) int {
+	x, y, _ := rescale(d, other)
+
+	return x.Cmp(y)
+}
+
+// Equal reports whether d and other are numerically equal.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Add returns d + other with the larger of both scales.
+func (d Decimal) Add(other Decimal) Decimal {
+	x, y, scale := rescale(d, other)
+
+	return Decimal{unscaled: new(big.Int).Add(x, y), scale: scale}
+}
+
+// Sub returns d - other with the larger of both scales.
+func (d Decimal) Sub(other Decimal) Decimal {
+	x, y, scale := rescale(d, other)
+
+	return Decimal{unscaled: new(big.Int).Sub(x, y), scale: scale}
+}
+
+// Mul returns d × other with the sum of both scales.
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), other.int()), scale: d.scale + other.scale}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Abs returns |d|.
+func (d Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Div returns d / other rounded to scale digits with mode.
+func (d Decimal) Div(other Decimal, scale int32, mode RoundingMode) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, errors.New("decimal division by zero")
+	}
+
+	// Keep one extra digit beyond scale plus a sticky remainder so that
+	// rounding sees whether the discarded part is exactly half.
+	num := new(big.Int).Set(d.int())
+	den := new(big.Int).Set(other.int())
+
+	if shift := other.scale + scale + 1 - d.scale; shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	q.Mul(q, bigTen)
+	if r.Sign() != 0 {
+		if num.Sign()*den.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return Decimal{unscaled: q, scale: scale + 2}.Round(scale, mode), nil
+}
+
+// Round returns d with exactly scale digits after the decimal point,
+// discarding digits with mode or padding with zeros as needed. A negative
+// scale rounds to a multiple of 10^-scale and returns it at scale 0.
+func (d Decimal) Round(scale int32, mode RoundingMode) Decimal {
+	res := d.round(scale, mode)
+
+	if scale < 0 {
+		return NewDecimalFromBigInt(res.unscaled, scale)
+	}
+
+	return res
+}
+
+func (d Decimal) round(scale int32, mode RoundingMode) Decimal {
+	if scale >= d.scale {
+		return Decimal{unscaled: new(big.Int).Mul(d.int(), pow10(scale-d.scale)), scale: scale}
+	}
+
+	unit := pow10(d.scale - scale)
+	q, r := new(big.Int).QuoRem(d.int(), unit, new(big.Int))
+
+	if r.Sign() == 0 {
+		return Decimal{unscaled: q, scale: scale}
+	}
+
+	sign := d.Sign()
+	half := new(big.Int).Abs(r)
+	half.Mul(half, big.NewInt(2))
+	cmpHalf := half.Cmp(unit)
+
+	var away bool
+
+	switch mode {
+	case RoundHalfUp:
+		away = cmpHalf >= 0
+	case RoundHalfEven:
+		away = cmpHalf > 0 || (cmpHalf == 0 && q.Bit(0) == 1)
+	case RoundHalfDown:
+		away = cmpHalf > 0
+	case RoundUp:
+		away = true
+	case RoundFloor:
+		away = sign < 0
+	case RoundCeiling:
+		away = sign > 0
+	}
+
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+
+	return Decimal{unscaled: q, scale: scale}
+}
+
+// NullDecimal is a nullable arbitrary-precision decimal for NUMERIC and
+// DECIMAL columns.
+//
+// Scan accepts string, []byte, integers and floats; Value writes the exact
+// decimal string. JSON is written as a number or a string depending on
+// DecimalJSONFormat.
+type NullDecimal struct {
+	Decimal Decimal
+	Valid   bool
+}
+
+// Value method
+func (nd NullDecimal) Value() (driver.Value, error) {
+
+	if !nd.Valid {
+		return nil, nil
+	}
+
+	return nd.Decimal.String(), nil
+}
+
+// Scan method
+func (nd *NullDecimal) Scan(value interface{}) error {
+
+	if value == nil {
+		nd.Decimal, nd.Valid = Decimal{}, false
+		return nil
+	}
+
+	var (
+		res Decimal
+		err error
+	)
+
+	switch t := value.(type) {
+	case string:
+		res, err = ParseDecimal(t)
+
+	case []byte:
+		res, err = ParseDecimal(string(t))
+
+	case float64:
+		res, err = NewDecimalFromFloat(t)
+
+	case float32:
+		res, err = ParseDecimal(strconv.FormatFloat(float64(t), 'g', -1, 32))
+
+	case uint64:
+		res = NewDecimalFromBigInt(new(big.Int).SetUint64(t), 0)
+
+	case uint:
+		res = NewDecimalFromBigInt(new(big.Int).SetUint64(uint64(t)), 0)
+
+	default:
+		i, ierr := toInt64(value)
+		if ierr != nil {
+			return NewScanError("NullDecimal", value, ierr)
+		}
+		res = NewDecimal(i, 0)
+	}
+
+	if err != nil {
+		if errors.Is(err, ErrOverflow) {
+			return NewScanError("NullDecimal", value, err)
+		}
+		return parseError("NullDecimal", value, err)
+	}
+
+	nd.Decimal, nd.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nd NullDecimal) MarshalJSON() ([]byte, error) {
+
+	if !nd.Valid {
+		return NullType, nil
+	}
+
+	return marshalNumber(nd.Decimal.String(), DecimalJSONFormat), nil
+}
+
+// UnmarshalJSON method
+func (nd *NullDecimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nd = NullDecimal{}
+		return nil
+	}
+
+	str, err := unmarshalNumber(data)
+	if err != nil {
+		return err
+	}
+
+	res, err := ParseDecimal(str)
+	if err != nil {
+		return err
+	}
+
+	*nd = NullDecimal{Decimal: res, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nd NullDecimal) MarshalText() ([]byte, error) {
+
+	if !nd.Valid {
+		return NullText, nil
+	}
+
+	return []byte(nd.Decimal.String()), nil
+}
+
+// UnmarshalText method
+func (nd *NullDecimal) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nd = NullDecimal{}
+		return nil
+	}
+
+	res, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+
+	*nd = NullDecimal{Decimal: res, Valid: true}
+
+	return nil
+}
+
+// marshalNumber encodes the textual number str as a JSON number or string.
+func marshalNumber(str string, format NumberFormat) []byte {
+	if format == NumberFormatString {
+		return []byte(strconv.Quote(str))
+	}
+
+	return []byte(str)
+}
+
+// unmarshalNumber returns the text of a JSON number or numeric string.
+func unmarshalNumber(data []byte) (string, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+
+		err := json.Unmarshal(data, &str)
+		if err != nil {
+			return "", err
+		}
+
+		return str, nil
+	}
+
+	var n json.Number
+
+	err := json.Unmarshal(data, &n)
+	if err != nil {
+		return "", err
+	}
+
+	return n.String(), nil
+}
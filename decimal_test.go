@@ -0,0 +1,270 @@
+package nullish
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"12.30", "12.30"},
+		{"-0.05", "-0.05"},
+		{"+7", "7"},
+		{".5", "0.5"},
+		{"1.5e3", "1500"},
+		{"1.5E-3", "0.0015"},
+		{" 42 ", "42"},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789"},
+	}
+
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		if err != nil {
+			t.Fatalf("ParseDecimal(%q): unexpected error: %v", tt.in, err)
+		}
+		if d.String() != tt.want {
+			t.Errorf("ParseDecimal(%q): expected %s, got %s", tt.in, tt.want, d.String())
+		}
+	}
+
+	for _, in := range []string{"", "-", ".", "abc", "1.2.3", "NaN", "Infinity", "1e", "0x10"} {
+		if _, err := ParseDecimal(in); err == nil {
+			t.Errorf("ParseDecimal(%q): expected error", in)
+		}
+	}
+
+	for _, in := range []string{"1e-1000000000", "1e5000000", "1e131073", "1e-16384", "0.1e-16383", "1e100000000"} {
+		if _, err := ParseDecimal(in); !errors.Is(err, ErrOverflow) {
+			t.Errorf("ParseDecimal(%q): expected overflow error, got %v", in, err)
+		}
+	}
+
+	// The NUMERIC limits themselves are accepted
+	for _, in := range []string{"1e-16383", "1e131071", "0.001e131072"} {
+		if _, err := ParseDecimal(in); err != nil {
+			t.Errorf("ParseDecimal(%q): unexpected error: %v", in, err)
+		}
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a := MustParseDecimal("12.30")
+	b := MustParseDecimal("0.005")
+
+	if got := a.Add(b).String(); got != "12.305" {
+		t.Errorf("Add: expected 12.305, got %s", got)
+	}
+	if got := a.Sub(b).String(); got != "12.295" {
+		t.Errorf("Sub: expected 12.295, got %s", got)
+	}
+	if got := a.Mul(b).String(); got != "0.06150" {
+		t.Errorf("Mul: expected 0.06150, got %s", got)
+	}
+	if got := a.Neg().Abs().String(); got != "12.30" {
+		t.Errorf("Neg/Abs: expected 12.30, got %s", got)
+	}
+
+	q, err := NewDecimal(10, 0).Div(NewDecimal(3, 0), 4, RoundHalfUp)
+	if err != nil || q.String() != "3.3333" {
+		t.Errorf("Div: expected 3.3333, got %s err=%v", q, err)
+	}
+	q, err = NewDecimal(-2, 0).Div(NewDecimal(3, 0), 2, RoundHalfUp)
+	if err != nil || q.String() != "-0.67" {
+		t.Errorf("Div: expected -0.67, got %s err=%v", q, err)
+	}
+	if _, err = a.Div(Decimal{}, 2, RoundHalfUp); err == nil {
+		t.Error("expected division by zero error")
+	}
+
+	var zero Decimal
+	if got := zero.Add(a).String(); got != "12.30" {
+		t.Errorf("zero value Add: expected 12.30, got %s", got)
+	}
+}
+
+func TestDecimal_Cmp(t *testing.T) {
+	if !MustParseDecimal("1.5").Equal(MustParseDecimal("1.50")) {
+		t.Error("expected 1.5 == 1.50")
+	}
+	if MustParseDecimal("-1").Cmp(MustParseDecimal("0.001")) != -1 {
+		t.Error("expected -1 < 0.001")
+	}
+	if MustParseDecimal("100").Cmp(MustParseDecimal("99.999")) != 1 {
+		t.Error("expected 100 > 99.999")
+	}
+}
+
+func TestDecimal_Round(t *testing.T) {
+	tests := []struct {
+		in   string
+		mode RoundingMode
+		want string
+	}{
+		{"2.345", RoundHalfUp, "2.35"},
+		{"-2.345", RoundHalfUp, "-2.35"},
+		{"2.345", RoundHalfEven, "2.34"},
+		{"2.355", RoundHalfEven, "2.36"},
+		{"2.345", RoundHalfDown, "2.34"},
+		{"2.3451", RoundHalfDown, "2.35"},
+		{"2.349", RoundDown, "2.34"},
+		{"-2.349", RoundDown, "-2.34"},
+		{"2.341", RoundUp, "2.35"},
+		{"-2.341", RoundFloor, "-2.35"},
+		{"2.341", RoundFloor, "2.34"},
+		{"2.341", RoundCeiling, "2.35"},
+		{"-2.341", RoundCeiling, "-2.34"},
+		{"2.3", RoundHalfUp, "2.30"},
+	}
+
+	for _, tt := range tests {
+		if got := MustParseDecimal(tt.in).Round(2, tt.mode).String(); got != tt.want {
+			t.Errorf("Round(%s, %d): expected %s, got %s", tt.in, tt.mode, tt.want, got)
+		}
+	}
+}
+
+func TestDecimal_RoundNegativeScale(t *testing.T) {
+	tests := []struct {
+		in    string
+		scale int32
+		mode  RoundingMode
+		want  string
+	}{
+		{"1234", -2, RoundHalfUp, "1200"},
+		{"1250", -2, RoundHalfUp, "1300"},
+		{"1250", -2, RoundHalfEven, "1200"},
+		{"-1234.56", -1, RoundFloor, "-1240"},
+		{"49", -2, RoundHalfUp, "0"},
+	}
+
+	for _, tt := range tests {
+		got := MustParseDecimal(tt.in).Round(tt.scale, tt.mode)
+		if got.String() != tt.want || got.Scale() != 0 {
+			t.Errorf("Round(%s, %d): expected %s at scale 0, got %s at scale %d", tt.in, tt.scale, tt.want, got, got.Scale())
+		}
+	}
+
+	q, err := MustParseDecimal("123456").Div(NewDecimal(1, 0), -2, RoundHalfUp)
+	if err != nil || q.String() != "123500" || q.Scale() != 0 {
+		t.Errorf("Div(123456, 1, -2): expected 123500, got %s err=%v", q, err)
+	}
+
+	q, err = MustParseDecimal("1000").Div(MustParseDecimal("0.3"), -3, RoundDown)
+	if err != nil || q.String() != "3000" {
+		t.Errorf("Div(1000, 0.3, -3): expected 3000, got %s err=%v", q, err)
+	}
+}
+
+func TestNullDecimal_ValueScan(t *testing.T) {
+	nd := NewNullDecimal(MustParseDecimal("12345678901234567890.01"), true)
+	got, err := nd.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "12345678901234567890.01" {
+		t.Errorf("expected exact string, got %v", got)
+	}
+
+	got, err = NullDecimal{}.Value()
+	if err != nil || got != nil {
+		t.Errorf("expected nil, got %v err=%v", got, err)
+	}
+
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{"12.30", "12.30"},
+		{[]byte("-0.001"), "-0.001"},
+		{int64(42), "42"},
+		{uint64(18446744073709551615), "18446744073709551615"},
+		{float64(0.1), "0.1"},
+	}
+
+	for _, tt := range tests {
+		var nd NullDecimal
+		if err := nd.Scan(tt.value); err != nil {
+			t.Fatalf("Scan(%v): unexpected error: %v", tt.value, err)
+		}
+		if nd.Decimal.String() != tt.want || !nd.Valid {
+			t.Errorf("Scan(%v): expected %s, got %+v", tt.value, tt.want, nd)
+		}
+	}
+
+	if err := nd.Scan("abc"); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+	if err := nd.Scan(true); !errors.Is(err, ErrUnsupportedSource) {
+		t.Errorf("expected unsupported source error, got %v", err)
+	}
+	if err := nd.Scan(nil); err != nil || nd.Valid {
+		t.Errorf("expected Valid=false for nil, got %+v err=%v", nd, err)
+	}
+}
+
+func TestNullDecimal_ExponentLimit(t *testing.T) {
+	var nd NullDecimal
+
+	if err := nd.Scan("1e-1000000000"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+	if nd.Valid {
+		t.Error("expected invalid after failed scan")
+	}
+
+	if err := json.Unmarshal([]byte(`"1e5000000"`), &nd); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`1e5000000`), &nd); err == nil {
+		t.Error("expected error")
+	}
+	if err := nd.UnmarshalText([]byte("1e-1000000000")); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+}
+
+func TestNullDecimal_JSON(t *testing.T) {
+	nd := NewNullDecimal(MustParseDecimal("12.30"), true)
+
+	data, err := json.Marshal(nd)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != "12.30" {
+		t.Errorf("expected 12.30, got %s", data)
+	}
+
+	defer func(format NumberFormat) { DecimalJSONFormat = format }(DecimalJSONFormat)
+	DecimalJSONFormat = NumberFormatString
+
+	data, err = json.Marshal(nd)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `"12.30"` {
+		t.Errorf(`expected "12.30", got %s`, data)
+	}
+
+	for _, in := range []string{`12.30`, `"12.30"`, `1.230e1`} {
+		var decoded NullDecimal
+		if err := json.Unmarshal([]byte(in), &decoded); err != nil {
+			t.Fatalf("unmarshal %s: %v", in, err)
+		}
+		if !decoded.Decimal.Equal(nd.Decimal) || !decoded.Valid {
+			t.Errorf("unmarshal %s: expected 12.30, got %+v", in, decoded)
+		}
+	}
+
+	var decoded NullDecimal
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded.Valid {
+		t.Errorf("expected Valid=false for null, got %+v err=%v", decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`"abc"`), &decoded); err == nil {
+		t.Error("expected error for non-numeric string")
+	}
+}
@@ -0,0 +1,605 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// DurationFormat selects how NullDuration is encoded in JSON.
+type DurationFormat int
+
+const (
+	// DurationFormatString encodes durations as Go duration strings such as
+	// "1h30m0s".
+	DurationFormatString DurationFormat = iota
+
+	// DurationFormatISO8601 encodes durations as ISO 8601 strings such as
+	// "PT1H30M". Days are never emitted, since a day is not always 24h.
+	DurationFormatISO8601
+
+	// DurationFormatMillis encodes durations as integer milliseconds,
+	// truncating smaller units.
+	DurationFormatMillis
+)
+
+// DurationJSONFormat is the format NullDuration.MarshalJSON emits.
+// UnmarshalJSON accepts all formats regardless.
+var DurationJSONFormat = DurationFormatString
+
+// NullDuration is a nullable time.Duration.
+//
+// Scan accepts int64 nanoseconds and string or []byte holding a Go duration
+// ("1h30m"), an ISO 8601 duration ("P1DT2H") or a PostgreSQL interval
+// ("1 day 02:03:04"); days count as 24h and months are rejected. Value
+// writes a clock string such as "-36:00:00.5" that PostgreSQL INTERVAL and
+// MySQL TIME columns accept; PostgreSQL keeps microseconds only.
+type NullDuration struct {
+	Duration time.Duration
+	Valid    bool
+}
+
+// Value method
+func (nd NullDuration) Value() (driver.Value, error) {
+
+	if !nd.Valid {
+		return nil, nil
+	}
+
+	return formatClockDuration(nd.Duration), nil
+}
+
+// Scan method
+func (nd *NullDuration) Scan(value interface{}) error {
+
+	if value == nil {
+		nd.Duration, nd.Valid = 0, false
+		return nil
+	}
+
+	var (
+		res time.Duration
+		err error
+	)
+
+	switch t := value.(type) {
+	case time.Duration:
+		res = t
+
+	case string:
+		res, err = ParseDuration(t)
+
+	case []byte:
+		res, err = ParseDuration(string(t))
+
+	default:
+		i, ierr := toInt64(value)
+		if ierr != nil {
+			return NewScanError("NullDuration", value, ierr)
+		}
+		res = time.Duration(i)
+	}
+
+	if err != nil {
+		if errors.Is(err, ErrOverflow) {
+			return NewScanError("NullDuration", value, err)
+		}
+		return parseError("NullDuration", value, err)
+	}
+
+	nd.Duration, nd.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nd NullDuration) MarshalJSON() ([]byte, error) {
+
+	if !nd.Valid {
+		return NullType, nil
+	}
+
+	switch DurationJSONFormat {
+	case DurationFormatISO8601:
+		return json.Marshal(FormatISO8601Duration(nd.Duration))
+	case DurationFormatMillis:
+		return []byte(strconv.FormatInt(nd.Duration.Milliseconds(), 10)), nil
+	}
+
+	return json.Marshal(nd.Duration.String())
+}
+
+// UnmarshalJSON method
+func (nd *NullDuration) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nd = NullDuration{}
+		return nil
+	}
+
+	if len(data) > 0 && data[0] != '"' {
+		ms, err := unmarshalInt(data, 64)
+		if err != nil {
+			return err
+		}
+
+		if ms > math.MaxInt64/int64(time.Millisecond) || ms < math.MinInt64/int64(time.Millisecond) {
+			return ErrOverflow
+		}
+
+		*nd = NullDuration{Duration: time.Duration(ms) * time.Millisecond, Valid: true}
+
+		return nil
+	}
+
+	var res string
+
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		return err
+	}
+
+	d, err := ParseDuration(res)
+	if err != nil {
+		return err
+	}
+
+	*nd = NullDuration{Duration: d, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nd NullDuration) MarshalText() ([]byte, error) {
+
+	if !nd.Valid {
+		return NullText, nil
+	}
+
+	return []byte(nd.Duration.String()), nil
+}
+
+// UnmarshalText method
+func (nd *NullDuration) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nd = NullDuration{}
+		return nil
+	}
+
+	res, err := ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	*nd = NullDuration{Duration: res, Valid: true}
+
+	return nil
+}
+
+// ParseDuration parses a Go duration string, an ISO 8601 duration or a
+// PostgreSQL interval. Days count as 24h; years and months are rejected
+// because their length depends on the calendar.
+func ParseDuration(s string) (time.Duration, error) {
+	str := strings.TrimSpace(s)
+
+	if !isISO8601Duration(str) {
+		if d, err := time.ParseDuration(str); err == nil {
+			return d, nil
+		}
+	}
+
+	parts, err := parseIntervalParts(str)
+	if err != nil {
+		return 0, err
+	}
+
+	if parts.months != 0 {
+		return 0, fmt.Errorf("duration %q has months, which have no fixed length", s)
+	}
+
+	parts.seconds = parts.seconds.Add(NewDecimalFromBigInt(new(big.Int).Mul(big.NewInt(parts.days), big.NewInt(24*60*60)), 0))
+
+	d, err := parts.timeIn(time.Nanosecond, RoundHalfEven)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(d), nil
+}
+
+// FormatISO8601Duration formats d as an ISO 8601 duration using hours,
+// minutes and seconds, e.g. "PT36H0.5S" or "-PT1M".
+func FormatISO8601Duration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+
+	b.WriteString("PT")
+
+	hours, u := u/uint64(time.Hour), u%uint64(time.Hour)
+	minutes, u := u/uint64(time.Minute), u%uint64(time.Minute)
+
+	if hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if u > 0 {
+		b.WriteString(formatSeconds(u/uint64(time.Second), u%uint64(time.Second)) + "S")
+	}
+
+	return b.String()
+}
+
+// formatClockDuration formats d as [-]hh:mm:ss with a fraction of nanos,
+// e.g. "36:00:00.5" or "-00:01:00".
+func formatClockDuration(d time.Duration) string {
+	sign := ""
+
+	u := uint64(d)
+	if d < 0 {
+		sign = "-"
+		u = -u
+	}
+
+	hours, u := u/uint64(time.Hour), u%uint64(time.Hour)
+	minutes, u := u/uint64(time.Minute), u%uint64(time.Minute)
+
+	seconds := formatSeconds(u/uint64(time.Second), u%uint64(time.Second))
+	if u < uint64(10*time.Second) {
+		seconds = "0" + seconds
+	}
+
+	return fmt.Sprintf("%s%02d:%02d:%s", sign, hours, minutes, seconds)
+}
+
+// formatSeconds formats seconds with a fraction of nanos, without trailing
+// zeros.
+func formatSeconds(seconds, nanos uint64) string {
+	s := strconv.FormatUint(seconds, 10)
+	if nanos != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0")
+	}
+
+	return s
+}
+
+// intervalParts is a textual duration split into the calendar-relative
+// months and days and the exact time, as PostgreSQL stores intervals. The
+// time is kept as exact seconds so that NullInterval can hold microsecond
+// counts beyond the range of time.Duration.
+type intervalParts struct {
+	months  int64
+	days    int64
+	seconds Decimal
+}
+
+func (p *intervalParts) negate() {
+	p.months, p.days, p.seconds = -p.months, -p.days, p.seconds.Neg()
+}
+
+// timeIn returns the time part as a count of unit, rounded with mode, or
+// ErrOverflow if it does not fit in an int64.
+func (p intervalParts) timeIn(unit time.Duration, mode RoundingMode) (int64, error) {
+	n := p.seconds.Mul(NewDecimal(int64(time.Second/unit), 0)).Round(0, mode).int()
+	if !n.IsInt64() {
+		return 0, ErrOverflow
+	}
+
+	return n.Int64(), nil
+}
+
+func isISO8601Duration(s string) bool {
+	return strings.HasPrefix(s, "P") || strings.HasPrefix(s, "-P")
+}
+
+// parseIntervalParts parses an ISO 8601 duration or an interval in the
+// postgres, postgres_verbose or sql_standard output styles of PostgreSQL.
+func parseIntervalParts(s string) (intervalParts, error) {
+	if isISO8601Duration(s) {
+		return parseISO8601Interval(s)
+	}
+
+	return parseSQLInterval(s)
+}
+
+// parseISO8601Interval parses "P1Y2M3W4DT5H6M7.5S". Each component may
+// carry its own sign, as in the iso_8601 style of PostgreSQL, and a leading
+// '-' negates the whole duration.
+func parseISO8601Interval(s string) (intervalParts, error) {
+	var (
+		parts intervalParts
+		neg   bool
+	)
+
+	str := s
+	if strings.HasPrefix(str, "-") {
+		neg, str = true, str[1:]
+	}
+
+	str = str[1:]
+	if str == "" {
+		return parts, fmt.Errorf("invalid duration %q", s)
+	}
+
+	inTime := false
+
+	for str != "" {
+		if str[0] == 'T' {
+			if inTime {
+				return parts, fmt.Errorf("invalid duration %q", s)
+			}
+			inTime, str = true, str[1:]
+			continue
+		}
+
+		i := strings.IndexFunc(str, func(r rune) bool { return r >= 'A' && r <= 'Z' })
+		if i <= 0 {
+			return parts, fmt.Errorf("invalid duration %q", s)
+		}
+
+		number, unit := str[:i], str[i]
+		str = str[i+1:]
+
+		var err error
+
+		switch {
+		case !inTime && unit == 'Y':
+			err = addIntervalInt(&parts.months, number, 12)
+		case !inTime && unit == 'M':
+			err = addIntervalInt(&parts.months, number, 1)
+		case !inTime && unit == 'W':
+			err = addIntervalInt(&parts.days, number, 7)
+		case !inTime && unit == 'D':
+			err = addIntervalInt(&parts.days, number, 1)
+		case inTime && unit == 'H':
+			err = addIntervalTime(&parts.seconds, number, time.Hour)
+		case inTime && unit == 'M':
+			err = addIntervalTime(&parts.seconds, number, time.Minute)
+		case inTime && unit == 'S':
+			err = addIntervalTime(&parts.seconds, number, time.Second)
+		default:
+			err = strconv.ErrSyntax
+		}
+
+		if err != nil {
+			return parts, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+	}
+
+	if neg {
+		parts.negate()
+	}
+
+	return parts, nil
+}
+
+// intervalUnits maps the unit words of PostgreSQL intervals to the part
+// and multiplier they add to.
+var intervalUnits = map[string]struct {
+	months, days int64
+	exact        time.Duration
+}{
+	"millennium": {months: 12000}, "millenniums": {months: 12000}, "millennia": {months: 12000},
+	"century": {months: 1200}, "centuries": {months: 1200},
+	"decade": {months: 120}, "decades": {months: 120},
+	"year": {months: 12}, "years": {months: 12}, "yr": {months: 12}, "yrs": {months: 12}, "y": {months: 12},
+	"mon": {months: 1}, "mons": {months: 1}, "month": {months: 1}, "months": {months: 1},
+	"week": {days: 7}, "weeks": {days: 7}, "w": {days: 7},
+	"day": {days: 1}, "days": {days: 1}, "d": {days: 1},
+	"hour": {exact: time.Hour}, "hours": {exact: time.Hour}, "hr": {exact: time.Hour}, "hrs": {exact: time.Hour}, "h": {exact: time.Hour},
+	"minute": {exact: time.Minute}, "minutes": {exact: time.Minute}, "min": {exact: time.Minute}, "mins": {exact: time.Minute}, "m": {exact: time.Minute},
+	"second": {exact: time.Second}, "seconds": {exact: time.Second}, "sec": {exact: time.Second}, "secs": {exact: time.Second}, "s": {exact: time.Second},
+	"millisecond": {exact: time.Millisecond}, "milliseconds": {exact: time.Millisecond}, "msec": {exact: time.Millisecond}, "msecs": {exact: time.Millisecond}, "ms": {exact: time.Millisecond},
+	"microsecond": {exact: time.Microsecond}, "microseconds": {exact: time.Microsecond}, "usec": {exact: time.Microsecond}, "usecs": {exact: time.Microsecond}, "us": {exact: time.Microsecond},
+}
+
+// parseSQLInterval parses the postgres ("1 year 2 mons -3 days +04:05:06"),
+// postgres_verbose ("@ 1 year 2 mons -3 days 4 hours ago") and sql_standard
+// ("1-2 -3 4:05:06") interval styles.
+func parseSQLInterval(s string) (intervalParts, error) {
+	var parts intervalParts
+
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) > 0 && fields[0] == "@" {
+		fields = fields[1:]
+	}
+
+	ago := len(fields) > 0 && fields[len(fields)-1] == "ago"
+	if ago {
+		fields = fields[:len(fields)-1]
+	}
+
+	if len(fields) == 0 {
+		return parts, fmt.Errorf("invalid interval %q", s)
+	}
+
+	// In the sql_standard style a single leading '-' negates every field
+	// when no other field carries a sign.
+	sqlStandard, signed := true, false
+	for i, f := range fields {
+		if _, ok := intervalUnits[f]; ok {
+			sqlStandard = false
+		}
+		if i > 0 && (f[0] == '-' || f[0] == '+') {
+			signed = true
+		}
+	}
+
+	negateAll := sqlStandard && !signed && fields[0][0] == '-'
+	if negateAll {
+		fields[0] = fields[0][1:]
+	}
+
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+
+		var err error
+
+		switch {
+		case strings.Contains(f, ":"):
+			err = addIntervalClock(&parts.seconds, f)
+
+		case sqlStandard && strings.Contains(strings.TrimLeft(f, "+-"), "-"):
+			err = addIntervalYearMonth(&parts.months, f)
+
+		case i+1 < len(fields) && !sqlStandard:
+			unit, ok := intervalUnits[fields[i+1]]
+			if !ok {
+				err = strconv.ErrSyntax
+				break
+			}
+			i++
+
+			switch {
+			case unit.months != 0:
+				err = addIntervalInt(&parts.months, f, unit.months)
+			case unit.days != 0:
+				err = addIntervalInt(&parts.days, f, unit.days)
+			default:
+				err = addIntervalTime(&parts.seconds, f, unit.exact)
+			}
+
+		case sqlStandard && i+1 < len(fields):
+			// A bare number before the time field is a day count.
+			err = addIntervalInt(&parts.days, f, 1)
+
+		case sqlStandard:
+			// A lone trailing number is a second count.
+			err = addIntervalTime(&parts.seconds, f, time.Second)
+
+		default:
+			err = strconv.ErrSyntax
+		}
+
+		if err != nil {
+			return parts, fmt.Errorf("invalid interval %q: %w", s, err)
+		}
+	}
+
+	if negateAll != ago {
+		parts.negate()
+	}
+
+	return parts, nil
+}
+
+// addIntervalInt adds the integer number × multiplier to dst.
+func addIntervalInt(dst *int64, number string, multiplier int64) error {
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	if n > math.MaxInt64/multiplier || n < math.MinInt64/multiplier {
+		return ErrOverflow
+	}
+
+	return addInterval(dst, n*multiplier)
+}
+
+// addIntervalTime adds the possibly fractional number × unit, in seconds,
+// to dst.
+func addIntervalTime(dst *Decimal, number string, unit time.Duration) error {
+	d, err := ParseDecimal(number)
+	if err != nil || strings.ContainsAny(number, "eE") {
+		return strconv.ErrSyntax
+	}
+
+	*dst = dst.Add(d.Mul(NewDecimal(int64(unit), 9)))
+
+	return nil
+}
+
+// addIntervalClock adds a [+-]h:m[:s[.f]] field to dst.
+func addIntervalClock(dst *Decimal, field string) error {
+	negative, clock := false, field
+	switch field[0] {
+	case '-':
+		negative, clock = true, field[1:]
+	case '+':
+		clock = field[1:]
+	}
+
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return strconv.ErrSyntax
+	}
+
+	var total Decimal
+
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		if i >= len(parts) {
+			break
+		}
+
+		if parts[i] == "" || parts[i][0] == '-' || parts[i][0] == '+' {
+			return strconv.ErrSyntax
+		}
+
+		err := addIntervalTime(&total, parts[i], unit)
+		if err != nil {
+			return err
+		}
+	}
+
+	if negative {
+		total = total.Neg()
+	}
+
+	*dst = dst.Add(total)
+
+	return nil
+}
+
+// addIntervalYearMonth adds a [+-]y-m field to dst.
+func addIntervalYearMonth(dst *int64, field string) error {
+	sign, ym := "", field
+	if field[0] == '-' || field[0] == '+' {
+		sign, ym = field[:1], field[1:]
+	}
+
+	years, months, _ := strings.Cut(ym, "-")
+
+	var total int64
+
+	err := addIntervalInt(&total, years, 12)
+	if err == nil {
+		err = addIntervalInt(&total, months, 1)
+	}
+	if err != nil {
+		return err
+	}
+
+	if sign == "-" {
+		total = -total
+	}
+
+	return addInterval(dst, total)
+}
+
+func addInterval(dst *int64, n int64) error {
+	if (n > 0 && *dst > math.MaxInt64-n) || (n < 0 && *dst < math.MinInt64-n) {
+		return ErrOverflow
+	}
+
+	*dst += n
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package nullish
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"1h30m", 90 * time.Minute},
+		{"-1.5s", -1500 * time.Millisecond},
+		{"P1DT2H", 26 * time.Hour},
+		{"PT0.5S", 500 * time.Millisecond},
+		{"-PT1M", -time.Minute},
+		{"P1W", 7 * 24 * time.Hour},
+		{"P-1DT2H", -22 * time.Hour},
+		{"1 day 02:03:04", 26*time.Hour + 3*time.Minute + 4*time.Second},
+		{"-1 days +02:03:04", -22*time.Hour + 3*time.Minute + 4*time.Second},
+		{"3 days", 72 * time.Hour},
+		{"02:03:04.123456", 2*time.Hour + 3*time.Minute + 4123456*time.Microsecond},
+		{"-00:00:01", -time.Second},
+		{"@ 1 day 2 hours ago", -26 * time.Hour},
+		{"1 2:00:00", 26 * time.Hour},
+		{"-1 2:00:00", -26 * time.Hour},
+		{"1 hour 30 mins", 90 * time.Minute},
+		{"10", 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.in)
+		if err != nil {
+			t.Fatalf("ParseDuration(%q): unexpected error: %v", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseDuration(%q): expected %v, got %v", tt.in, tt.want, got)
+		}
+	}
+
+	for _, in := range []string{"", "P", "P1M", "1 mon", "1 year", "P1H", "PT1D", "1 fortnight", "abc", "1:2:3:4"} {
+		if _, err := ParseDuration(in); err == nil {
+			t.Errorf("ParseDuration(%q): expected error", in)
+		}
+	}
+
+	if _, err := ParseDuration("200000 days"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+}
+
+func TestFormatISO8601Duration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "PT0S"},
+		{36*time.Hour + 500*time.Millisecond, "PT36H0.5S"},
+		{-time.Minute, "-PT1M"},
+		{90*time.Minute + time.Nanosecond, "PT1H30M0.000000001S"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatISO8601Duration(tt.in); got != tt.want {
+			t.Errorf("FormatISO8601Duration(%v): expected %s, got %s", tt.in, tt.want, got)
+		}
+
+		back, err := ParseDuration(tt.want)
+		if err != nil || back != tt.in {
+			t.Errorf("ParseDuration(%q): expected %v, got %v err=%v", tt.want, tt.in, back, err)
+		}
+	}
+}
+
+func TestNullDuration_ValueRoundTrip(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "00:00:00"},
+		{time.Nanosecond, "00:00:00.000000001"},
+		{36*time.Hour + 500*time.Millisecond, "36:00:00.5"},
+		{-time.Minute, "-00:01:00"},
+		{-(90*time.Minute + 12*time.Second), "-01:30:12"},
+		{math.MaxInt64, "2562047:47:16.854775807"},
+		{math.MinInt64, "-2562047:47:16.854775808"},
+	}
+
+	for _, tt := range tests {
+		got, err := NewNullDuration(tt.in, true).Value()
+		if err != nil || got != tt.want {
+			t.Errorf("Value(%v): expected %q, got %v err=%v", tt.in, tt.want, got, err)
+			continue
+		}
+
+		back, err := ParseDuration(got.(string))
+		if err != nil || back != tt.in {
+			t.Errorf("ParseDuration(%q): expected %v, got %v err=%v", got, tt.in, back, err)
+		}
+	}
+}
+
+func TestNullDuration_ValueScan(t *testing.T) {
+	nd := NewNullDuration(1500*time.Millisecond, true)
+	got, err := nd.Value()
+	if err != nil || got != "00:00:01.5" {
+		t.Errorf("expected 00:00:01.5, got %v err=%v", got, err)
+	}
+
+	got, err = NullDuration{}.Value()
+	if err != nil || got != nil {
+		t.Errorf("expected nil, got %v err=%v", got, err)
+	}
+
+	tests := []struct {
+		value interface{}
+		want  time.Duration
+	}{
+		{int64(1500000000), 1500 * time.Millisecond},
+		{"1.5s", 1500 * time.Millisecond},
+		{[]byte("PT1.5S"), 1500 * time.Millisecond},
+		{"00:00:01.5", 1500 * time.Millisecond},
+		{time.Minute, time.Minute},
+	}
+
+	for _, tt := range tests {
+		var nd NullDuration
+		if err := nd.Scan(tt.value); err != nil {
+			t.Fatalf("Scan(%v): unexpected error: %v", tt.value, err)
+		}
+		if nd.Duration != tt.want || !nd.Valid {
+			t.Errorf("Scan(%v): expected %v, got %+v", tt.value, tt.want, nd)
+		}
+	}
+
+	if err := nd.Scan("1 mon"); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+	if err := nd.Scan("200000 days"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+	if err := nd.Scan(true); !errors.Is(err, ErrUnsupportedSource) {
+		t.Errorf("expected unsupported source error, got %v", err)
+	}
+	if err := nd.Scan(nil); err != nil || nd.Valid {
+		t.Errorf("expected Valid=false for nil, got %+v err=%v", nd, err)
+	}
+}
+
+func TestNullDuration_JSON(t *testing.T) {
+	defer func(format DurationFormat) { DurationJSONFormat = format }(DurationJSONFormat)
+
+	nd := NewNullDuration(90*time.Minute+1500*time.Microsecond, true)
+
+	tests := []struct {
+		format DurationFormat
+		want   string
+	}{
+		{DurationFormatString, `"1h30m0.0015s"`},
+		{DurationFormatISO8601, `"PT1H30M0.0015S"`},
+		{DurationFormatMillis, `5400001`},
+	}
+
+	for _, tt := range tests {
+		DurationJSONFormat = tt.format
+
+		data, err := json.Marshal(nd)
+		if err != nil {
+			t.Fatalf("marshal error: %v", err)
+		}
+		if string(data) != tt.want {
+			t.Errorf("format %d: expected %s, got %s", tt.format, tt.want, data)
+		}
+
+		var decoded NullDuration
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("unmarshal %s: %v", data, err)
+		}
+		if decoded.Duration.Truncate(time.Millisecond) != nd.Duration.Truncate(time.Millisecond) || !decoded.Valid {
+			t.Errorf("unmarshal %s: got %+v", data, decoded)
+		}
+	}
+
+	var decoded NullDuration
+	if err := json.Unmarshal([]byte(`"1 day 02:00:00"`), &decoded); err != nil || decoded.Duration != 26*time.Hour {
+		t.Errorf("expected 26h, got %+v err=%v", decoded, err)
+	}
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded.Valid {
+		t.Errorf("expected Valid=false for null, got %+v err=%v", decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`1.5`), &decoded); err == nil {
+		t.Error("expected error for fractional milliseconds")
+	}
+	if err := json.Unmarshal([]byte(`""`), &decoded); err == nil {
+		t.Error("expected error for empty string")
+	}
+}
@@ -0,0 +1,103 @@
+package nullish
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+var (
+	// ErrUnsupportedSource is reported when Scan receives a value of a type
+	// the target cannot be built from.
+	ErrUnsupportedSource = errors.New("unsupported source type")
+
+	// ErrOverflow is reported when the source value does not fit in the
+	// target type.
+	ErrOverflow = errors.New("value out of range")
+
+	// ErrPrecision is reported when converting the source value to the
+	// target type would lose precision, e.g. a float with a fraction
+	// scanned into an integer.
+	ErrPrecision = errors.New("value loses precision")
+
+	// ErrParse is reported when a textual source value cannot be parsed.
+	ErrParse = errors.New("cannot parse value")
+)
+
+// maxScanErrorValue is the maximum number of runes of the source value kept
+// in a ScanError.
+const maxScanErrorValue = 64
+
+// ScanError describes a failed Scan. Use errors.Is with ErrUnsupportedSource,
+// ErrOverflow, ErrPrecision or ErrParse to classify it, and errors.As to
+// inspect it.
+type ScanError struct {
+	// Target is the name of the type being scanned into, e.g. "NullInt".
+	Target string
+
+	// Source is the Go type of the source value, e.g. "[]uint8".
+	Source string
+
+	// Value is a rendering of the source value, truncated to 64 runes.
+	Value string
+
+	// Err is the cause of the failure.
+	Err error
+}
+
+// NewScanError returns a ScanError for a failed scan of value into target.
+func NewScanError(target string, value interface{}, err error) *ScanError {
+	var rendered string
+
+	switch v := value.(type) {
+	case []byte:
+		rendered = string(v)
+	case string:
+		rendered = v
+	default:
+		rendered = fmt.Sprint(v)
+	}
+
+	if utf8.RuneCountInString(rendered) > maxScanErrorValue {
+		rendered = string([]rune(rendered)[:maxScanErrorValue]) + "..."
+	}
+
+	return &ScanError{
+		Target: target,
+		Source: fmt.Sprintf("%T", value),
+		Value:  rendered,
+		Err:    err,
+	}
+}
+
+// Error method
+func (e *ScanError) Error() string {
+	return fmt.Sprintf("nullish: cannot scan %s %q into %s: %v", e.Source, e.Value, e.Target, e.Err)
+}
+
+// Unwrap method
+func (e *ScanError) Unwrap() error {
+	return e.Err
+}
+
+// unsupportedSourceError reports a source value of a type target cannot be
+// built from.
+func unsupportedSourceError(target string, value interface{}) error {
+	return NewScanError(target, value, ErrUnsupportedSource)
+}
+
+// parseError reports a source value that could not be parsed, keeping both
+// ErrParse and the underlying cause reachable through errors.Is and errors.As.
+func parseError(target string, value interface{}, cause error) error {
+	return NewScanError(target, value, wrapParseError(cause))
+}
+
+// wrapParseError wraps cause so it matches ErrParse with errors.Is.
+func wrapParseError(cause error) error {
+	return fmt.Errorf("%w: %w", ErrParse, cause)
+}
+
+// overflowError reports a source value that does not fit in target.
+func overflowError(target string, value interface{}) error {
+	return NewScanError(target, value, ErrOverflow)
+}
@@ -0,0 +1,102 @@
+package nullish
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestScanError_Sentinels(t *testing.T) {
+	var ni NullInt
+	var nf NullFloat
+	var ns NullString
+	var nb NullBool
+	var nt NullTime
+	var nu NullUUID
+	var nl NullULID
+	var no NullObj
+	var nj NullJSON
+	var np Null[int8]
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"NullInt unsupported", ni.Scan(true), ErrUnsupportedSource},
+		{"NullInt parse", ni.Scan([]byte("abc")), ErrParse},
+		{"NullFloat unsupported", nf.Scan(true), ErrUnsupportedSource},
+		{"NullFloat parse", nf.Scan([]byte("abc")), ErrParse},
+		{"NullString unsupported", ns.Scan(struct{}{}), ErrUnsupportedSource},
+		{"NullBool unsupported", nb.Scan(struct{}{}), ErrUnsupportedSource},
+		{"NullTime unsupported", nt.Scan(struct{}{}), ErrUnsupportedSource},
+		{"NullUUID unsupported", nu.Scan(123), ErrUnsupportedSource},
+		{"NullUUID parse", nu.Scan("not-a-uuid"), ErrParse},
+		{"NullULID parse", nl.Scan("not-a-ulid"), ErrParse},
+		{"NullObj unsupported", no.Scan(42), ErrUnsupportedSource},
+		{"NullObj parse", no.Scan([]byte(`{"a":`)), ErrParse},
+		{"NullJSON unsupported", nj.Scan(42), ErrUnsupportedSource},
+		{"Null overflow", np.Scan(int64(300)), ErrOverflow},
+		{"Null parse", np.Scan("abc"), ErrParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, tt.err)
+			}
+
+			var scanErr *ScanError
+			if !errors.As(tt.err, &scanErr) {
+				t.Fatalf("expected *ScanError, got %T", tt.err)
+			}
+			if scanErr.Target == "" || scanErr.Source == "" {
+				t.Errorf("expected target and source to be set, got %+v", scanErr)
+			}
+		})
+	}
+}
+
+func TestScanError_Fields(t *testing.T) {
+	var ni NullInt
+	err := ni.Scan([]byte("12x"))
+
+	var scanErr *ScanError
+	if !errors.As(err, &scanErr) {
+		t.Fatalf("expected *ScanError, got %T", err)
+	}
+
+	if scanErr.Target != "NullInt" {
+		t.Errorf("expected Target=NullInt, got %s", scanErr.Target)
+	}
+	if scanErr.Source != "[]uint8" {
+		t.Errorf("expected Source=[]uint8, got %s", scanErr.Source)
+	}
+	if scanErr.Value != "12x" {
+		t.Errorf("expected Value=12x, got %s", scanErr.Value)
+	}
+
+	// The underlying cause stays reachable
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Errorf("expected *strconv.NumError in chain, got %v", err)
+	}
+
+	expected := `nullish: cannot scan []uint8 "12x" into NullInt: cannot parse value: strconv.ParseInt: parsing "12x": invalid syntax`
+	if err.Error() != expected {
+		t.Errorf("expected %s, got %s", expected, err.Error())
+	}
+}
+
+func TestScanError_Truncate(t *testing.T) {
+	long := strings.Repeat("é", 100)
+
+	err := NewScanError("NullInt", long, ErrParse)
+	if !strings.HasSuffix(err.Value, "...") {
+		t.Errorf("expected truncated value, got %s", err.Value)
+	}
+	if n := len([]rune(strings.TrimSuffix(err.Value, "..."))); n != 64 {
+		t.Errorf("expected 64 runes, got %d", n)
+	}
+}
@@ -3,12 +3,13 @@ package nullish
 import (
 	"bytes"
 	"database/sql/driver"
-	"errors"
 	"strconv"
-
-	"github.com/goccy/go-json"
 )
 
+// NullFloat is a nullable float64.
+//
+// Scan accepts floats, integers exactly representable as float64 (SQLite
+// returns int64 for whole REAL values) and their textual forms.
 type NullFloat struct {
 	Float float64
 	Valid bool
@@ -16,12 +17,7 @@ type NullFloat struct {
 
 // Value method
 func (nf NullFloat) Value() (driver.Value, error) {
-
-	if !nf.Valid {
-		return nil, nil
-	}
-
-	return nf.Float, nil
+	return NewNull(nf.Float, nf.Valid).Value()
 }
 
 // Scan method
@@ -32,47 +28,53 @@ func (nf *NullFloat) Scan(value interface{}) error {
 		return nil
 	}
 
-	switch t := value.(type) {
-	case float32:
-		nf.Float, nf.Valid = float64(t), true
+	f, err := toFloat64(value)
+	if err != nil {
+		return NewScanError("NullFloat", value, err)
+	}
+
+	nf.Float, nf.Valid = f, true
+
+	return nil
+}
 
-	case float64:
-		nf.Float, nf.Valid = t, true
+// MarshalJSON method
+func (nf NullFloat) MarshalJSON() ([]byte, error) {
+	return NewNull(nf.Float, nf.Valid).MarshalJSON()
+}
 
-	case []byte:
-		f, err := strconv.ParseFloat(string(t), 64)
-		if err != nil {
-			return errors.New("type assertion []byte to float64 is failed")
-		}
-		nf.Float, nf.Valid = f, true
+// UnmarshalJSON method
+func (nf *NullFloat) UnmarshalJSON(data []byte) error {
+	var res Null[float64]
 
-	default:
-		return errors.New("type assertion to float64 is failed")
+	err := res.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
 
+	*nf = NullFloat{Float: res.V, Valid: res.Valid}
+
 	return nil
 }
 
-// MarshalJSON method
-func (nf NullFloat) MarshalJSON() ([]byte, error) {
+// MarshalText method
+func (nf NullFloat) MarshalText() ([]byte, error) {
 
 	if !nf.Valid {
-		return NullType, nil
+		return NullText, nil
 	}
 
-	return json.Marshal(nf.Float)
+	return []byte(strconv.FormatFloat(nf.Float, 'g', -1, 64)), nil
 }
 
-// UnmarshalJSON method
-func (nf *NullFloat) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, NullType) {
+// UnmarshalText method
+func (nf *NullFloat) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
 		*nf = NullFloat{}
 		return nil
 	}
 
-	var res float64
-
-	err := json.Unmarshal(data, &res)
+	res, err := strconv.ParseFloat(string(text), 64)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,8 @@
 ﻿package nullish
 
 import (
+	"errors"
+	"math"
 	"testing"
 
 	"github.com/goccy/go-json"
@@ -46,6 +48,58 @@ func TestNullFloat_JSON(t *testing.T) {
 	}
 }
 
+func TestNullFloat_ScanCoercion(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  float64
+	}{
+		{"int64", int64(42), 42},
+		{"int", -3, -3},
+		{"uint32", uint32(7), 7},
+		{"large exact int64", int64(1 << 60), 1 << 60},
+		{"string", "1.25", 1.25},
+		{"bytes", []byte("-0.5"), -0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nf NullFloat
+			err := nf.Scan(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if nf.Float != tt.want || !nf.Valid {
+				t.Errorf("expected Float=%v Valid=true, got Float=%v Valid=%v", tt.want, nf.Float, nf.Valid)
+			}
+		})
+	}
+}
+
+func TestNullFloat_ScanLossy(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  error
+	}{
+		{"inexact int64", int64(1<<53 + 1), ErrPrecision},
+		{"inexact uint64", uint64(math.MaxUint64), ErrPrecision},
+		{"text out of range", "1e400", ErrOverflow},
+		{"text", "abc", ErrParse},
+		{"bool", true, ErrUnsupportedSource},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nf NullFloat
+			err := nf.Scan(tt.value)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
 func BenchmarkNullFloat_Value(b *testing.B) {
 	nf := NewNullFloat(3.14, true)
 	for i := 0; i < b.N; i++ {
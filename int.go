@@ -3,12 +3,15 @@ package nullish
 import (
 	"bytes"
 	"database/sql/driver"
-	"errors"
+	"math"
 	"strconv"
-
-	"github.com/goccy/go-json"
 )
 
+// NullInt is a nullable platform sized int.
+//
+// Scan accepts signed and unsigned integers, floats holding an integral
+// value and their textual forms, failing with ErrOverflow when the value
+// does not fit in an int and ErrPrecision when a fraction would be dropped.
 type NullInt struct {
 	Int   int
 	Valid bool
@@ -32,56 +35,57 @@ func (ni *NullInt) Scan(value interface{}) error {
 		return nil
 	}
 
-	switch b := value.(type) {
-	case int:
-		ni.Int, ni.Valid = b, true
+	i, err := toInt64(value)
+	if err != nil {
+		return NewScanError("NullInt", value, err)
+	}
 
-	case int8:
-		ni.Int, ni.Valid = int(b), true
+	if i < math.MinInt || i > math.MaxInt {
+		return overflowError("NullInt", value)
+	}
 
-	case int16:
-		ni.Int, ni.Valid = int(b), true
+	ni.Int, ni.Valid = int(i), true
 
-	case int32:
-		ni.Int, ni.Valid = int(b), true
+	return nil
+}
 
-	case int64:
-		ni.Int, ni.Valid = int(b), true
+// MarshalJSON method
+func (ni NullInt) MarshalJSON() ([]byte, error) {
+	return NewNull(ni.Int, ni.Valid).MarshalJSON()
+}
 
-	case []byte:
-		a, err := strconv.Atoi(string(b))
-		if err != nil {
-			return errors.New("type assertion to int is failed")
-		}
-		ni.Int, ni.Valid = a, true
+// UnmarshalJSON method
+func (ni *NullInt) UnmarshalJSON(data []byte) error {
+	var res Null[int]
 
-	default:
-		return errors.New("type assertion to int is failed")
+	err := res.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
 
+	*ni = NullInt{Int: res.V, Valid: res.Valid}
+
 	return nil
 }
 
-// MarshalJSON method
-func (ni NullInt) MarshalJSON() ([]byte, error) {
+// MarshalText method
+func (ni NullInt) MarshalText() ([]byte, error) {
 
 	if !ni.Valid {
-		return NullType, nil
+		return NullText, nil
 	}
 
-	return json.Marshal(ni.Int)
+	return []byte(strconv.Itoa(ni.Int)), nil
 }
 
-// UnmarshalJSON method
-func (ni *NullInt) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, NullType) {
+// UnmarshalText method
+func (ni *NullInt) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
 		*ni = NullInt{}
 		return nil
 	}
 
-	var res int
-
-	err := json.Unmarshal(data, &res)
+	res, err := strconv.Atoi(string(text))
 	if err != nil {
 		return err
 	}
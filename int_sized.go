@@ -0,0 +1,344 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"strconv"
+)
+
+// The sized integer types match SMALLINT, INTEGER and BIGINT columns and
+// fixed width protobuf fields. Scan and UnmarshalJSON are range checked and
+// fail with ErrOverflow, or a *strconv.NumError for JSON, when the value
+// does not fit.
+
+// NullInt8 is a nullable int8.
+type NullInt8 struct {
+	Int8  int8
+	Valid bool
+}
+
+// Value method
+func (ni NullInt8) Value() (driver.Value, error) {
+
+	if !ni.Valid {
+		return nil, nil
+	}
+
+	return int64(ni.Int8), nil
+}
+
+// Scan method
+func (ni *NullInt8) Scan(value interface{}) error {
+
+	if value == nil {
+		ni.Int8, ni.Valid = 0, false
+		return nil
+	}
+
+	res, err := toIntN(value, 8)
+	if err != nil {
+		return NewScanError("NullInt8", value, err)
+	}
+
+	ni.Int8, ni.Valid = int8(res), true
+
+	return nil
+}
+
+// MarshalJSON method
+func (ni NullInt8) MarshalJSON() ([]byte, error) {
+	return NewNull(ni.Int8, ni.Valid).MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (ni *NullInt8) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*ni = NullInt8{}
+		return nil
+	}
+
+	res, err := unmarshalInt(data, 8)
+	if err != nil {
+		return err
+	}
+
+	*ni = NullInt8{Int8: int8(res), Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (ni NullInt8) MarshalText() ([]byte, error) {
+
+	if !ni.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatInt(int64(ni.Int8), 10)), nil
+}
+
+// UnmarshalText method
+func (ni *NullInt8) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*ni = NullInt8{}
+		return nil
+	}
+
+	res, err := strconv.ParseInt(string(text), 10, 8)
+	if err != nil {
+		return err
+	}
+
+	*ni = NullInt8{Int8: int8(res), Valid: true}
+
+	return nil
+}
+
+// NullInt16 is a nullable int16, matching SMALLINT columns.
+type NullInt16 struct {
+	Int16 int16
+	Valid bool
+}
+
+// Value method
+func (ni NullInt16) Value() (driver.Value, error) {
+
+	if !ni.Valid {
+		return nil, nil
+	}
+
+	return int64(ni.Int16), nil
+}
+
+// Scan method
+func (ni *NullInt16) Scan(value interface{}) error {
+
+	if value == nil {
+		ni.Int16, ni.Valid = 0, false
+		return nil
+	}
+
+	res, err := toIntN(value, 16)
+	if err != nil {
+		return NewScanError("NullInt16", value, err)
+	}
+
+	ni.Int16, ni.Valid = int16(res), true
+
+	return nil
+}
+
+// MarshalJSON method
+func (ni NullInt16) MarshalJSON() ([]byte, error) {
+	return NewNull(ni.Int16, ni.Valid).MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (ni *NullInt16) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*ni = NullInt16{}
+		return nil
+	}
+
+	res, err := unmarshalInt(data, 16)
+	if err != nil {
+		return err
+	}
+
+	*ni = NullInt16{Int16: int16(res), Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (ni NullInt16) MarshalText() ([]byte, error) {
+
+	if !ni.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatInt(int64(ni.Int16), 10)), nil
+}
+
+// UnmarshalText method
+func (ni *NullInt16) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*ni = NullInt16{}
+		return nil
+	}
+
+	res, err := strconv.ParseInt(string(text), 10, 16)
+	if err != nil {
+		return err
+	}
+
+	*ni = NullInt16{Int16: int16(res), Valid: true}
+
+	return nil
+}
+
+// NullInt32 is a nullable int32, matching INTEGER columns.
+type NullInt32 struct {
+	Int32 int32
+	Valid bool
+}
+
+// Value method
+func (ni NullInt32) Value() (driver.Value, error) {
+
+	if !ni.Valid {
+		return nil, nil
+	}
+
+	return int64(ni.Int32), nil
+}
+
+// Scan method
+func (ni *NullInt32) Scan(value interface{}) error {
+
+	if value == nil {
+		ni.Int32, ni.Valid = 0, false
+		return nil
+	}
+
+	res, err := toIntN(value, 32)
+	if err != nil {
+		return NewScanError("NullInt32", value, err)
+	}
+
+	ni.Int32, ni.Valid = int32(res), true
+
+	return nil
+}
+
+// MarshalJSON method
+func (ni NullInt32) MarshalJSON() ([]byte, error) {
+	return NewNull(ni.Int32, ni.Valid).MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (ni *NullInt32) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*ni = NullInt32{}
+		return nil
+	}
+
+	res, err := unmarshalInt(data, 32)
+	if err != nil {
+		return err
+	}
+
+	*ni = NullInt32{Int32: int32(res), Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (ni NullInt32) MarshalText() ([]byte, error) {
+
+	if !ni.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatInt(int64(ni.Int32), 10)), nil
+}
+
+// UnmarshalText method
+func (ni *NullInt32) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*ni = NullInt32{}
+		return nil
+	}
+
+	res, err := strconv.ParseInt(string(text), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	*ni = NullInt32{Int32: int32(res), Valid: true}
+
+	return nil
+}
+
+// NullInt64 is a nullable int64, matching BIGINT columns.
+type NullInt64 struct {
+	Int64 int64
+	Valid bool
+}
+
+// Value method
+func (ni NullInt64) Value() (driver.Value, error) {
+
+	if !ni.Valid {
+		return nil, nil
+	}
+
+	return ni.Int64, nil
+}
+
+// Scan method
+func (ni *NullInt64) Scan(value interface{}) error {
+
+	if value == nil {
+		ni.Int64, ni.Valid = 0, false
+		return nil
+	}
+
+	res, err := toIntN(value, 64)
+	if err != nil {
+		return NewScanError("NullInt64", value, err)
+	}
+
+	ni.Int64, ni.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (ni NullInt64) MarshalJSON() ([]byte, error) {
+	return NewNull(ni.Int64, ni.Valid).MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (ni *NullInt64) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*ni = NullInt64{}
+		return nil
+	}
+
+	res, err := unmarshalInt(data, 64)
+	if err != nil {
+		return err
+	}
+
+	*ni = NullInt64{Int64: res, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (ni NullInt64) MarshalText() ([]byte, error) {
+
+	if !ni.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatInt(ni.Int64, 10)), nil
+}
+
+// UnmarshalText method
+func (ni *NullInt64) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*ni = NullInt64{}
+		return nil
+	}
+
+	res, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*ni = NullInt64{Int64: res, Valid: true}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package nullish
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+func TestNullInt32_Value(t *testing.T) {
+	ni := NewNullInt32(-42, true)
+	got, err := ni.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != int64(-42) {
+		t.Errorf("expected -42, got %v", got)
+	}
+
+	ni = NewNullInt32(0, false)
+	got, err = ni.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
+
+func TestNullIntN_Scan(t *testing.T) {
+	var n8 NullInt8
+	if err := n8.Scan(int64(-128)); err != nil || n8.Int8 != -128 || !n8.Valid {
+		t.Errorf("expected Int8=-128, got %+v err=%v", n8, err)
+	}
+	if err := n8.Scan(int64(128)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow for 128, got %v", err)
+	}
+
+	var n16 NullInt16
+	if err := n16.Scan([]byte("32767")); err != nil || n16.Int16 != 32767 {
+		t.Errorf("expected Int16=32767, got %+v err=%v", n16, err)
+	}
+	if err := n16.Scan(uint32(40000)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow for 40000, got %v", err)
+	}
+
+	var n32 NullInt32
+	if err := n32.Scan(float64(7)); err != nil || n32.Int32 != 7 {
+		t.Errorf("expected Int32=7, got %+v err=%v", n32, err)
+	}
+	if err := n32.Scan(int64(math.MaxInt32 + 1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow, got %v", err)
+	}
+
+	var n64 NullInt64
+	if err := n64.Scan("-9223372036854775808"); err != nil || n64.Int64 != math.MinInt64 {
+		t.Errorf("expected Int64=MinInt64, got %+v err=%v", n64, err)
+	}
+	if err := n64.Scan(nil); err != nil || n64.Valid {
+		t.Errorf("expected Valid=false for nil, got %+v err=%v", n64, err)
+	}
+}
+
+func TestNullIntN_JSON(t *testing.T) {
+	n16 := NewNullInt16(-300, true)
+	data, err := json.Marshal(n16)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	var decoded NullInt16
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if decoded != n16 {
+		t.Errorf("roundtrip failed: expected %+v, got %+v", n16, decoded)
+	}
+
+	var n8 NullInt8
+	if err := json.Unmarshal([]byte("300"), &n8); err == nil {
+		t.Error("expected error for out of range int8")
+	}
+	if err := json.Unmarshal([]byte("1.5"), &n8); err == nil {
+		t.Error("expected error for fraction")
+	}
+
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded.Valid {
+		t.Errorf("expected Valid=false for null, got %+v err=%v", decoded, err)
+	}
+}
+
+func TestNullIntN_Text(t *testing.T) {
+	var n32 NullInt32
+	if err := n32.UnmarshalText([]byte("123")); err != nil || n32.Int32 != 123 {
+		t.Errorf("expected Int32=123, got %+v err=%v", n32, err)
+	}
+	if err := n32.UnmarshalText([]byte("3000000000")); err == nil {
+		t.Error("expected error for out of range int32")
+	}
+
+	text, err := NewNullInt64(-1, true).MarshalText()
+	if err != nil || string(text) != "-1" {
+		t.Errorf("expected -1, got %s err=%v", text, err)
+	}
+}
@@ -1,6 +1,9 @@
 package nullish
 
 import (
+	"errors"
+	"math"
+	"strconv"
 	"testing"
 
 	"github.com/goccy/go-json"
@@ -63,6 +66,101 @@ func TestNullInt_JSON(t *testing.T) {
 	}
 }
 
+func TestNullInt_ScanCoercion(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  int
+	}{
+		{"uint8", uint8(200), 200},
+		{"uint64", uint64(42), 42},
+		{"float64 integral", float64(42), 42},
+		{"float32 integral", float32(-7), -7},
+		{"string", "123", 123},
+		{"bytes", []byte("-5"), -5},
+		{"numeric text", []byte("42.00"), 42},
+		{"large numeric text", "1234567890.00", 1234567890},
+		{"negative numeric text", "-2147483648.000", math.MinInt32},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ni NullInt
+			err := ni.Scan(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ni.Int != tt.want || !ni.Valid {
+				t.Errorf("expected Int=%d Valid=true, got Int=%d Valid=%v", tt.want, ni.Int, ni.Valid)
+			}
+		})
+	}
+}
+
+func TestNullInt_ScanLossy(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  error
+	}{
+		{"uint64 above max int", uint64(math.MaxUint64), ErrOverflow},
+		{"float with fraction", 1.5, ErrPrecision},
+		{"NaN", math.NaN(), ErrPrecision},
+		{"float above max int", 1e19, ErrOverflow},
+		{"text above max int", "9223372036854775808", ErrOverflow},
+		{"text with fraction", "1.5", ErrPrecision},
+		{"text with fraction past float precision", "9007199254740993.5", ErrPrecision},
+		{"text with tiny fraction", "42.0000000000000000001", ErrPrecision},
+		{"numeric text above max int", "9223372036854775808.00", ErrOverflow},
+		{"text", "abc", ErrParse},
+		{"bool", true, ErrUnsupportedSource},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ni NullInt
+			err := ni.Scan(tt.value)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestNullInt_ScanPlatformSize(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  int64
+	}{
+		{"int64 above max int32", int64(math.MaxInt32 + 1), math.MaxInt32 + 1},
+		{"large numeric text", "1234567890123456789.00", 1234567890123456789},
+		{"negative numeric text", "-9223372036854775808.000", math.MinInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ni NullInt
+			err := ni.Scan(tt.value)
+
+			// int is 32 bits wide on 386 and arm
+			if strconv.IntSize == 32 {
+				if !errors.Is(err, ErrOverflow) || ni.Valid {
+					t.Errorf("expected ErrOverflow, got %+v err=%v", ni, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if int64(ni.Int) != tt.want || !ni.Valid {
+				t.Errorf("expected Int=%d Valid=true, got Int=%d Valid=%v", tt.want, ni.Int, ni.Valid)
+			}
+		})
+	}
+}
+
 func BenchmarkNullInt_Value(b *testing.B) {
 	ni := NewNullInt(42, true)
 	for i := 0; i < b.N; i++ {
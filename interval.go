@@ -0,0 +1,304 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// NullInterval is a nullable PostgreSQL INTERVAL. Months and days are kept
+// apart from the exact microseconds because their length depends on the
+// date they are applied to.
+//
+// Scan accepts string and []byte in the postgres, postgres_verbose,
+// sql_standard and iso_8601 interval styles, and time.Duration. Value
+// writes the canonical postgres style, e.g. "1 year 2 mons 3 days
+// 04:05:06.5"; JSON and text use ISO 8601, e.g. "P1Y2M3DT4H5M6.5S".
+type NullInterval struct {
+	Months       int32
+	Days         int32
+	Microseconds int64
+	Valid        bool
+}
+
+// ParseInterval parses an interval in any PostgreSQL output style.
+// Digits beyond microseconds are truncated.
+func ParseInterval(s string) (NullInterval, error) {
+	parts, err := parseIntervalParts(strings.TrimSpace(s))
+	if err != nil {
+		return NullInterval{}, err
+	}
+
+	if parts.months > math.MaxInt32 || parts.months < math.MinInt32 ||
+		parts.days > math.MaxInt32 || parts.days < math.MinInt32 {
+		return NullInterval{}, ErrOverflow
+	}
+
+	micros, err := parts.timeIn(time.Microsecond, RoundDown)
+	if err != nil {
+		return NullInterval{}, err
+	}
+
+	return NullInterval{
+		Months:       int32(parts.months),
+		Days:         int32(parts.days),
+		Microseconds: micros,
+		Valid:        true,
+	}, nil
+}
+
+// Value method
+func (ni NullInterval) Value() (driver.Value, error) {
+
+	if !ni.Valid {
+		return nil, nil
+	}
+
+	return ni.String(), nil
+}
+
+// Scan method
+func (ni *NullInterval) Scan(value interface{}) error {
+
+	if value == nil {
+		*ni = NullInterval{}
+		return nil
+	}
+
+	var (
+		res NullInterval
+		err error
+	)
+
+	switch t := value.(type) {
+	case time.Duration:
+		res = NullInterval{Microseconds: t.Microseconds(), Valid: true}
+
+	case string:
+		res, err = ParseInterval(t)
+
+	case []byte:
+		res, err = ParseInterval(string(t))
+
+	default:
+		return unsupportedSourceError("NullInterval", value)
+	}
+
+	if err != nil {
+		if errors.Is(err, ErrOverflow) {
+			return NewScanError("NullInterval", value, err)
+		}
+		return parseError("NullInterval", value, err)
+	}
+
+	*ni = res
+
+	return nil
+}
+
+// MarshalJSON method
+func (ni NullInterval) MarshalJSON() ([]byte, error) {
+
+	if !ni.Valid {
+		return NullType, nil
+	}
+
+	return json.Marshal(ni.ISO8601())
+}
+
+// UnmarshalJSON method
+func (ni *NullInterval) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*ni = NullInterval{}
+		return nil
+	}
+
+	var res string
+
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		return err
+	}
+
+	interval, err := ParseInterval(res)
+	if err != nil {
+		return err
+	}
+
+	*ni = interval
+
+	return nil
+}
+
+// MarshalText method
+func (ni NullInterval) MarshalText() ([]byte, error) {
+
+	if !ni.Valid {
+		return NullText, nil
+	}
+
+	return []byte(ni.ISO8601()), nil
+}
+
+// UnmarshalText method
+func (ni *NullInterval) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*ni = NullInterval{}
+		return nil
+	}
+
+	res, err := ParseInterval(string(text))
+	if err != nil {
+		return err
+	}
+
+	*ni = res
+
+	return nil
+}
+
+// AddTo returns t moved by the interval, applying months, then days, then
+// the exact time, as PostgreSQL does. Adding months keeps the day of month
+// but clamps it to the end of a shorter month, so 2024-01-31 plus one month
+// is 2024-02-29. A null interval returns t unchanged.
+func (ni NullInterval) AddTo(t time.Time) time.Time {
+	if !ni.Valid {
+		return t
+	}
+
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+
+	month += time.Month(ni.Months)
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+		day = last
+	}
+
+	res := time.Date(year, month, day+int(ni.Days), hour, min, sec, t.Nanosecond(), t.Location())
+
+	// Go through Unix seconds, as a time.Duration overflows past about
+	// 292 years while an interval holds about 292 thousand.
+	secs, nsecs := ni.Microseconds/1e6, ni.Microseconds%1e6*1e3
+
+	return time.Unix(res.Unix()+secs, int64(res.Nanosecond())+nsecs).In(res.Location())
+}
+
+// String returns the interval in the postgres output style, or "" if it is
+// null.
+func (ni NullInterval) String() string {
+	if !ni.Valid {
+		return ""
+	}
+
+	var (
+		b        strings.Builder
+		isZero   = true
+		isBefore = false
+	)
+
+	addPart := func(value int64, unit string) {
+		if value == 0 {
+			return
+		}
+
+		if !isZero {
+			b.WriteByte(' ')
+		}
+		if isBefore && value > 0 {
+			b.WriteByte('+')
+		}
+
+		b.WriteString(strconv.FormatInt(value, 10) + " " + unit)
+		if value != 1 {
+			b.WriteByte('s')
+		}
+
+		isBefore, isZero = value < 0, false
+	}
+
+	addPart(int64(ni.Months/12), "year")
+	addPart(int64(ni.Months%12), "mon")
+	addPart(int64(ni.Days), "day")
+
+	if isZero || ni.Microseconds != 0 {
+		if !isZero {
+			b.WriteByte(' ')
+		}
+
+		u := uint64(ni.Microseconds)
+		switch {
+		case ni.Microseconds < 0:
+			b.WriteByte('-')
+			u = -u
+		case isBefore:
+			b.WriteByte('+')
+		}
+
+		hours, u := u/3600000000, u%3600000000
+		minutes, u := u/60000000, u%60000000
+		seconds, micros := u/1000000, u%1000000
+
+		fmt.Fprintf(&b, "%02d:%02d:%02d", hours, minutes, seconds)
+		if micros != 0 {
+			b.WriteString(strings.TrimRight(fmt.Sprintf(".%06d", micros), "0"))
+		}
+	}
+
+	return b.String()
+}
+
+// ISO8601 returns the interval in the iso_8601 output style of PostgreSQL,
+// where each component carries its own sign, or "" if it is null.
+func (ni NullInterval) ISO8601() string {
+	if !ni.Valid {
+		return ""
+	}
+
+	if ni.Months == 0 && ni.Days == 0 && ni.Microseconds == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+
+	b.WriteByte('P')
+
+	for _, part := range []struct {
+		value int64
+		unit  byte
+	}{{int64(ni.Months / 12), 'Y'}, {int64(ni.Months % 12), 'M'}, {int64(ni.Days), 'D'}} {
+		if part.value != 0 {
+			b.WriteString(strconv.FormatInt(part.value, 10))
+			b.WriteByte(part.unit)
+		}
+	}
+
+	if ni.Microseconds != 0 {
+		sign, u := "", uint64(ni.Microseconds)
+		if ni.Microseconds < 0 {
+			sign, u = "-", -u
+		}
+
+		hours, u := u/3600000000, u%3600000000
+		minutes, u := u/60000000, u%60000000
+
+		b.WriteByte('T')
+		if hours != 0 {
+			b.WriteString(sign + strconv.FormatUint(hours, 10) + "H")
+		}
+		if minutes != 0 {
+			b.WriteString(sign + strconv.FormatUint(minutes, 10) + "M")
+		}
+		if u != 0 {
+			b.WriteString(sign + formatSeconds(u/1000000, u%1000000*1000) + "S")
+		}
+	}
+
+	return b.String()
+}
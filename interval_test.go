@@ -0,0 +1,201 @@
+package nullish
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want NullInterval
+	}{
+		{"postgres", "1 year 2 mons 3 days 04:05:06.5", NewNullInterval(14, 3, 14706500000, true)},
+		{"postgres mixed", "-1 years -2 mons +3 days -04:05:06", NewNullInterval(-14, 3, -14706000000, true)},
+		{"postgres time only", "-00:00:00.000001", NewNullInterval(0, 0, -1, true)},
+		{"postgres verbose", "@ 1 year 2 mons -3 days 4 hours 5 mins 6 secs ago", NewNullInterval(-14, 3, -14706000000, true)},
+		{"sql standard", "1-2 3 4:05:06", NewNullInterval(14, 3, 14706000000, true)},
+		{"sql standard negative", "-1-2 3 4:05:06", NewNullInterval(-14, -3, -14706000000, true)},
+		{"sql standard mixed", "+1-2 -3 +4:05:06", NewNullInterval(14, -3, 14706000000, true)},
+		{"sql standard year month", "1-2", NewNullInterval(14, 0, 0, true)},
+		{"iso 8601", "P1Y2M3DT4H5M6.5S", NewNullInterval(14, 3, 14706500000, true)},
+		{"iso 8601 signed", "P-1Y-2M3DT-4H-5M-6S", NewNullInterval(-14, 3, -14706000000, true)},
+		{"iso 8601 weeks", "P2W", NewNullInterval(0, 14, 0, true)},
+		{"beyond time.Duration", "3000000:00:00", NewNullInterval(0, 0, 3000000*3600000000, true)},
+		{"maximum", "2562047788:00:54.775807", NewNullInterval(0, 0, math.MaxInt64, true)},
+		{"minimum", "-2562047788:00:54.775808", NewNullInterval(0, 0, math.MinInt64, true)},
+		{"iso 8601 beyond time.Duration", "PT3000000H", NewNullInterval(0, 0, 3000000*3600000000, true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInterval(tt.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+
+	for _, in := range []string{"", "1 fortnight", "P", "1-2-3", "3 apples", "1h30m"} {
+		if _, err := ParseInterval(in); err == nil {
+			t.Errorf("ParseInterval(%q): expected error", in)
+		}
+	}
+
+	for _, in := range []string{"3000000000 days", "2562047788:00:54.775808", "3000000000 hours"} {
+		if _, err := ParseInterval(in); !errors.Is(err, ErrOverflow) {
+			t.Errorf("ParseInterval(%q): expected overflow error, got %v", in, err)
+		}
+	}
+}
+
+func TestNullInterval_String(t *testing.T) {
+	tests := []struct {
+		in       NullInterval
+		postgres string
+		iso      string
+	}{
+		{NewNullInterval(0, 0, 0, true), "00:00:00", "PT0S"},
+		{NewNullInterval(14, 3, 14706500000, true), "1 year 2 mons 3 days 04:05:06.5", "P1Y2M3DT4H5M6.5S"},
+		{NewNullInterval(1, 1, 0, true), "1 mon 1 day", "P1M1D"},
+		{NewNullInterval(0, -1, 7384000000, true), "-1 days +02:03:04", "P-1DT2H3M4S"},
+		{NewNullInterval(-14, 3, -14706000000, true), "-1 years -2 mons +3 days -04:05:06", "P-1Y-2M3DT-4H-5M-6S"},
+		{NewNullInterval(0, 0, 100*3600000000, true), "100:00:00", "PT100H"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.postgres {
+			t.Errorf("String(%+v): expected %q, got %q", tt.in, tt.postgres, got)
+		}
+		if got := tt.in.ISO8601(); got != tt.iso {
+			t.Errorf("ISO8601(%+v): expected %q, got %q", tt.in, tt.iso, got)
+		}
+
+		for _, s := range []string{tt.postgres, tt.iso} {
+			back, err := ParseInterval(s)
+			if err != nil || back != tt.in {
+				t.Errorf("ParseInterval(%q): expected %+v, got %+v err=%v", s, tt.in, back, err)
+			}
+		}
+	}
+}
+
+func TestNullInterval_ValueScan(t *testing.T) {
+	ni := NewNullInterval(1, 3, 0, true)
+	got, err := ni.Value()
+	if err != nil || got != "1 mon 3 days" {
+		t.Errorf("expected 1 mon 3 days, got %v err=%v", got, err)
+	}
+
+	got, err = NullInterval{}.Value()
+	if err != nil || got != nil {
+		t.Errorf("expected nil, got %v err=%v", got, err)
+	}
+
+	var scanned NullInterval
+	if err := scanned.Scan([]byte("1 mon 3 days")); err != nil || scanned != ni {
+		t.Errorf("expected %+v, got %+v err=%v", ni, scanned, err)
+	}
+	for _, want := range []NullInterval{
+		NewNullInterval(-14, 3, math.MaxInt64, true),
+		NewNullInterval(0, 0, math.MinInt64, true),
+		NewNullInterval(1, -1, 3000000*3600000000+1, true),
+	} {
+		value, _ := want.Value()
+		if err := scanned.Scan(value); err != nil || scanned != want {
+			t.Errorf("expected %+v from %v, got %+v err=%v", want, value, scanned, err)
+		}
+	}
+
+	if err := scanned.Scan(90 * time.Minute); err != nil || scanned != NewNullInterval(0, 0, 5400000000, true) {
+		t.Errorf("unexpected duration scan %+v err=%v", scanned, err)
+	}
+	if err := scanned.Scan("sometime"); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+	if err := scanned.Scan(int64(1)); !errors.Is(err, ErrUnsupportedSource) {
+		t.Errorf("expected unsupported source error, got %v", err)
+	}
+	if err := scanned.Scan(nil); err != nil || scanned.Valid {
+		t.Errorf("expected Valid=false for nil, got %+v err=%v", scanned, err)
+	}
+}
+
+func TestNullInterval_JSON(t *testing.T) {
+	ni := NewNullInterval(14, 3, 14706500000, true)
+
+	data, err := json.Marshal(ni)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `"P1Y2M3DT4H5M6.5S"` {
+		t.Errorf("unexpected JSON %s", data)
+	}
+
+	var decoded NullInterval
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != ni {
+		t.Errorf("roundtrip failed: expected %+v, got %+v err=%v", ni, decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`"1 year 2 mons 3 days 04:05:06.5"`), &decoded); err != nil || decoded != ni {
+		t.Errorf("expected %+v, got %+v err=%v", ni, decoded, err)
+	}
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded.Valid {
+		t.Errorf("expected Valid=false for null, got %+v err=%v", decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`""`), &decoded); err == nil {
+		t.Error("expected error for empty string")
+	}
+}
+
+func TestNullInterval_AddTo(t *testing.T) {
+	start := time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+
+	got := NewNullInterval(1, 3, 3600000000, true).AddTo(start)
+	if want := time.Date(2024, 3, 3, 11, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	got = NewNullInterval(-13, 0, 0, true).AddTo(time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	// A day is a calendar day, not 24h, across a DST change
+	ny, err := time.LoadLocation("America/New_York")
+	if err == nil {
+		before := time.Date(2024, 3, 9, 12, 0, 0, 0, ny)
+		got = NewNullInterval(0, 1, 0, true).AddTo(before)
+		if want := time.Date(2024, 3, 10, 12, 0, 0, 0, ny); !got.Equal(want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	}
+
+	if got := (NullInterval{Days: 1}).AddTo(start); !got.Equal(start) {
+		t.Errorf("expected null interval to leave time unchanged, got %v", got)
+	}
+
+	// Past the 292 years a time.Duration can hold
+	var ni NullInterval
+	if err := ni.Scan("3504000 hours"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	y2k := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	if want := time.Date(2399, 9, 26, 0, 0, 0, 0, time.UTC); !ni.AddTo(y2k).Equal(want) {
+		t.Errorf("expected %v, got %v", want, ni.AddTo(y2k))
+	}
+
+	if err := ni.Scan("-3504000:00:01.5"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(1600, 4, 6, 23, 59, 58, 500000000, time.UTC); !ni.AddTo(y2k).Equal(want) {
+		t.Errorf("expected %v, got %v", want, ni.AddTo(y2k))
+	}
+}
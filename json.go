@@ -43,7 +43,7 @@ func (nj *NullJSON) Scan(value interface{}) error {
 		}
 
 	default:
-		return errors.New("invalid type json")
+		return unsupportedSourceError("NullJSON", value)
 	}
 
 	return nil
@@ -51,12 +51,7 @@ func (nj *NullJSON) Scan(value interface{}) error {
 
 // MarshalJSON method
 func (nj NullJSON) MarshalJSON() ([]byte, error) {
-
-	if !nj.Valid {
-		return NullType, nil
-	}
-
-	return json.Marshal(nj.Json)
+	return NewNull(nj.Json, nj.Valid).MarshalJSON()
 }
 
 // UnmarshalJSON method
@@ -70,3 +65,28 @@ func (nj *NullJSON) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+
+// decodeJSON decodes data into v after checking that it holds a JSON value
+// opening with the expected delimiter ('{' for objects, '[' for arrays).
+// A JSON null is accepted and reported as not valid.
+func decodeJSON(data []byte, delim byte, v interface{}) (bool, error) {
+	trimmed := bytes.TrimSpace(data)
+
+	if bytes.Equal(trimmed, NullType) {
+		return false, nil
+	}
+
+	if len(trimmed) == 0 || trimmed[0] != delim {
+		if delim == '{' {
+			return false, errors.New("json value is not an object")
+		}
+		return false, errors.New("json value is not an array")
+	}
+
+	err := json.Unmarshal(trimmed, v)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
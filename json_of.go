@@ -0,0 +1,109 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+
+	"github.com/goccy/go-json"
+)
+
+// NullJSONOf is a nullable JSON column decoded straight into T.
+//
+// Set Strict before scanning or unmarshaling to reject objects carrying
+// fields unknown to T.
+type NullJSONOf[T any] struct {
+	V      T
+	Valid  bool
+	Strict bool
+}
+
+// Value method
+func (nj NullJSONOf[T]) Value() (driver.Value, error) {
+
+	if !nj.Valid {
+		return nil, nil
+	}
+
+	return json.Marshal(nj.V)
+}
+
+// Scan method
+func (nj *NullJSONOf[T]) Scan(value interface{}) error {
+
+	if value == nil {
+		var zero T
+		nj.V, nj.Valid = zero, false
+		return nil
+	}
+
+	var data []byte
+
+	switch t := value.(type) {
+	case string:
+		data = []byte(t)
+
+	case []byte:
+		data = t
+
+	case json.RawMessage:
+		data = t
+
+	default:
+		return unsupportedSourceError("NullJSONOf", value)
+	}
+
+	err := nj.decode(data)
+	if err != nil {
+		return parseError("NullJSONOf", value, err)
+	}
+
+	return nil
+}
+
+// MarshalJSON method
+func (nj NullJSONOf[T]) MarshalJSON() ([]byte, error) {
+
+	if !nj.Valid {
+		return NullType, nil
+	}
+
+	return json.Marshal(nj.V)
+}
+
+// UnmarshalJSON method
+func (nj *NullJSONOf[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nj = NullJSONOf[T]{Strict: nj.Strict}
+		return nil
+	}
+
+	return nj.decode(data)
+}
+
+func (nj *NullJSONOf[T]) decode(data []byte) error {
+	var res T
+
+	if bytes.Equal(bytes.TrimSpace(data), NullType) {
+		nj.V, nj.Valid = res, false
+		return nil
+	}
+
+	if nj.Strict {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+
+		err := dec.Decode(&res)
+		if err != nil {
+			return err
+		}
+	} else {
+		err := json.Unmarshal(data, &res)
+		if err != nil {
+			return err
+		}
+	}
+
+	nj.V, nj.Valid = res, true
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package nullish
+
+import (
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+type jsonOfAddress struct {
+	Street string `json:"street"`
+	City   string `json:"city"`
+}
+
+func TestNullJSONOf_Value(t *testing.T) {
+	nj := NewNullJSONOf(jsonOfAddress{Street: "Main", City: "NYC"}, true)
+	got, err := nj.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got.([]byte)) != `{"street":"Main","city":"NYC"}` {
+		t.Errorf("unexpected value %s", got)
+	}
+
+	nj = NewNullJSONOf(jsonOfAddress{}, false)
+	got, err = nj.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
+
+func TestNullJSONOf_Scan(t *testing.T) {
+	var nj NullJSONOf[jsonOfAddress]
+
+	err := nj.Scan([]byte(`{"street":"Main","city":"NYC"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nj.V.City != "NYC" || !nj.Valid {
+		t.Errorf("expected City=NYC Valid=true, got %+v", nj)
+	}
+
+	err = nj.Scan(`{"street":"Oak","city":"LA","zip":"90001"}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nj.V.City != "LA" {
+		t.Errorf("expected City=LA, got %+v", nj)
+	}
+
+	err = nj.Scan(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nj.Valid || nj.V.City != "" {
+		t.Errorf("expected zero value for nil, got %+v", nj)
+	}
+
+	for _, value := range []interface{}{"null", []byte(" null\n")} {
+		nj = NullJSONOf[jsonOfAddress]{V: jsonOfAddress{City: "NYC"}, Valid: true}
+		err = nj.Scan(value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if nj.Valid || nj.V.City != "" {
+			t.Errorf("expected zero value for JSON null %q, got %+v", value, nj)
+		}
+	}
+
+	err = nj.Scan(42)
+	if err == nil {
+		t.Error("expected error for invalid type")
+	}
+
+	err = nj.Scan([]byte(`{"street":`))
+	if err == nil {
+		t.Error("expected error for invalid json")
+	}
+}
+
+func TestNullJSONOf_Strict(t *testing.T) {
+	nj := NullJSONOf[jsonOfAddress]{Strict: true}
+
+	err := nj.Scan([]byte(`{"street":"Oak","zip":"90001"}`))
+	if err == nil {
+		t.Error("expected error for unknown field")
+	}
+
+	err = nj.UnmarshalJSON([]byte(`{"street":"Oak","zip":"90001"}`))
+	if err == nil {
+		t.Error("expected error for unknown field")
+	}
+
+	err = nj.UnmarshalJSON([]byte("null"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !nj.Strict {
+		t.Error("expected Strict to survive null")
+	}
+
+	err = nj.UnmarshalJSON([]byte(`{"street":"Oak"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nj.V.Street != "Oak" || !nj.Valid {
+		t.Errorf("expected Street=Oak Valid=true, got %+v", nj)
+	}
+}
+
+func TestNullJSONOf_JSON(t *testing.T) {
+	type wrapper struct {
+		Address NullJSONOf[jsonOfAddress] `json:"address"`
+	}
+
+	w := wrapper{Address: NewNullJSONOf(jsonOfAddress{Street: "Main", City: "NYC"}, true)}
+	data, err := json.Marshal(w)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `{"address":{"street":"Main","city":"NYC"}}` {
+		t.Errorf("unexpected json %s", data)
+	}
+
+	var decoded wrapper
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if decoded.Address != w.Address {
+		t.Errorf("roundtrip failed: expected %+v, got %+v", w.Address, decoded.Address)
+	}
+
+	data, err = json.Marshal(wrapper{})
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `{"address":null}` {
+		t.Errorf("expected null address, got %s", data)
+	}
+}
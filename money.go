@@ -0,0 +1,319 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+// ErrCurrencyMismatch is returned by Money arithmetic and comparison when
+// the operands have different currencies.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// CurrencyMinorUnits maps ISO 4217 currency codes to their number of minor
+// units. Currencies not listed have 2.
+var CurrencyMinorUnits = map[string]int32{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0,
+	"XOF": 0, "XPF": 0,
+	"CLF": 4, "UYW": 4,
+}
+
+// MoneyRoundingMode is the rounding NewMoney and Money.Mul apply to bring
+// amounts to the minor units of their currency.
+var MoneyRoundingMode = RoundHalfEven
+
+// MoneyFormat selects the textual form NullMoney.Value writes.
+type MoneyFormat int
+
+const (
+	// MoneyFormatText writes "12.30 EUR".
+	MoneyFormatText MoneyFormat = iota
+
+	// MoneyFormatComposite writes "(12.30,EUR)", the input form of a
+	// PostgreSQL composite type such as CREATE TYPE money_t AS
+	// (amount numeric, currency char(3)).
+	MoneyFormatComposite
+)
+
+// MoneyValueFormat is the form NullMoney.Value writes. Scan accepts both.
+var MoneyValueFormat = MoneyFormatText
+
+// MinorUnits returns the number of minor units of currency.
+func MinorUnits(currency string) int32 {
+	if units, ok := CurrencyMinorUnits[currency]; ok {
+		return units
+	}
+
+	return 2
+}
+
+// Money is a decimal amount in an ISO 4217 currency. The amount always has
+// exactly the minor units of its currency, e.g. 12.30 EUR or 1200 JPY.
+type Money struct {
+	Amount   Decimal
+	Currency string
+}
+
+// NewMoney returns amount in currency, rounded to the minor units of the
+// currency with MoneyRoundingMode.
+func NewMoney(amount Decimal, currency string) Money {
+	currency = strings.ToUpper(currency)
+
+	return Money{Amount: amount.Round(MinorUnits(currency), MoneyRoundingMode), Currency: currency}
+}
+
+// ParseMoney parses "12.30 EUR", "EUR 12.30" or the PostgreSQL composite
+// form "(12.30,EUR)". Amounts with more digits than the minor units of the
+// currency are rejected with ErrPrecision.
+func ParseMoney(s string) (Money, error) {
+	str := strings.TrimSpace(s)
+
+	var amount, currency string
+
+	if strings.HasPrefix(str, "(") && strings.HasSuffix(str, ")") {
+		var ok bool
+
+		amount, currency, ok = strings.Cut(str[1:len(str)-1], ",")
+		if !ok {
+			return Money{}, fmt.Errorf("invalid money %q", s)
+		}
+		amount, currency = strings.Trim(amount, `" `), strings.Trim(currency, `" `)
+	} else {
+		fields := strings.Fields(str)
+		if len(fields) != 2 {
+			return Money{}, fmt.Errorf("invalid money %q", s)
+		}
+
+		amount, currency = fields[0], fields[1]
+		if isCurrencyCode(amount) {
+			amount, currency = currency, amount
+		}
+	}
+
+	return newMoneyExact(amount, currency)
+}
+
+// newMoneyExact builds a Money from textual parts without rounding.
+func newMoneyExact(amount, currency string) (Money, error) {
+	if !isCurrencyCode(currency) {
+		return Money{}, fmt.Errorf("invalid currency code %q", currency)
+	}
+
+	currency = strings.ToUpper(currency)
+
+	d, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	rounded := d.Round(MinorUnits(currency), RoundDown)
+	if !rounded.Equal(d) {
+		return Money{}, fmt.Errorf("%w: %s has %d minor units", ErrPrecision, currency, MinorUnits(currency))
+	}
+
+	return Money{Amount: rounded, Currency: currency}, nil
+}
+
+func isCurrencyCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i] | 0x20
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns m as "12.30 EUR".
+func (m Money) String() string {
+	return m.Amount.String() + " " + m.Currency
+}
+
+// IsZero reports whether the amount of m is zero.
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// Add returns m + other, or ErrCurrencyMismatch.
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, mismatchError(m, other)
+	}
+
+	return Money{Amount: m.Amount.Add(other.Amount), Currency: m.Currency}, nil
+}
+
+// Sub returns m - other, or ErrCurrencyMismatch.
+func (m Money) Sub(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, mismatchError(m, other)
+	}
+
+	return Money{Amount: m.Amount.Sub(other.Amount), Currency: m.Currency}, nil
+}
+
+// Mul returns m × factor rounded to the minor units of the currency with
+// MoneyRoundingMode.
+func (m Money) Mul(factor Decimal) Money {
+	return NewMoney(m.Amount.Mul(factor), m.Currency)
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return Money{Amount: m.Amount.Neg(), Currency: m.Currency}
+}
+
+// Cmp compares m and other and returns -1, 0 or +1, or
+// ErrCurrencyMismatch.
+func (m Money) Cmp(other Money) (int, error) {
+	if m.Currency != other.Currency {
+		return 0, mismatchError(m, other)
+	}
+
+	return m.Amount.Cmp(other.Amount), nil
+}
+
+func mismatchError(a, b Money) error {
+	return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.Currency, b.Currency)
+}
+
+// NullMoney is a nullable Money.
+//
+// Scan accepts "12.30 EUR" and the PostgreSQL composite form "(12.30,EUR)"
+// as string or []byte; Value writes the form selected by MoneyValueFormat.
+// JSON is {"amount":"12.30","currency":"EUR"}.
+type NullMoney struct {
+	Money Money
+	Valid bool
+}
+
+type moneyJSON struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// Value method
+func (nm NullMoney) Value() (driver.Value, error) {
+
+	if !nm.Valid {
+		return nil, nil
+	}
+
+	if MoneyValueFormat == MoneyFormatComposite {
+		return "(" + nm.Money.Amount.String() + "," + nm.Money.Currency + ")", nil
+	}
+
+	return nm.Money.String(), nil
+}
+
+// Scan method
+func (nm *NullMoney) Scan(value interface{}) error {
+
+	if value == nil {
+		nm.Money, nm.Valid = Money{}, false
+		return nil
+	}
+
+	var str string
+
+	switch t := value.(type) {
+	case string:
+		str = t
+	case []byte:
+		str = string(t)
+	default:
+		return unsupportedSourceError("NullMoney", value)
+	}
+
+	res, err := ParseMoney(str)
+	if err != nil {
+		if errors.Is(err, ErrPrecision) {
+			return NewScanError("NullMoney", value, err)
+		}
+		return parseError("NullMoney", value, err)
+	}
+
+	nm.Money, nm.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nm NullMoney) MarshalJSON() ([]byte, error) {
+
+	if !nm.Valid {
+		return NullType, nil
+	}
+
+	return json.Marshal(moneyJSON{
+		Amount:   marshalNumber(nm.Money.Amount.String(), NumberFormatString),
+		Currency: nm.Money.Currency,
+	})
+}
+
+// UnmarshalJSON method
+func (nm *NullMoney) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nm = NullMoney{}
+		return nil
+	}
+
+	var res moneyJSON
+
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		return err
+	}
+
+	amount, err := unmarshalNumber(res.Amount)
+	if err != nil {
+		return err
+	}
+
+	money, err := newMoneyExact(amount, res.Currency)
+	if err != nil {
+		return err
+	}
+
+	*nm = NullMoney{Money: money, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nm NullMoney) MarshalText() ([]byte, error) {
+
+	if !nm.Valid {
+		return NullText, nil
+	}
+
+	return []byte(nm.Money.String()), nil
+}
+
+// UnmarshalText method
+func (nm *NullMoney) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nm = NullMoney{}
+		return nil
+	}
+
+	res, err := ParseMoney(string(text))
+	if err != nil {
+		return err
+	}
+
+	*nm = NullMoney{Money: res, Valid: true}
+
+	return nil
+}
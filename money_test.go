@@ -0,0 +1,152 @@
+package nullish
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"12.30 EUR", "12.30 EUR"},
+		{"12.3 eur", "12.30 EUR"},
+		{"EUR 12.30", "12.30 EUR"},
+		{"(12.30,EUR)", "12.30 EUR"},
+		{`("-0.5","USD")`, "-0.50 USD"},
+		{"1200 JPY", "1200 JPY"},
+		{"1.250 KWD", "1.250 KWD"},
+	}
+
+	for _, tt := range tests {
+		m, err := ParseMoney(tt.in)
+		if err != nil {
+			t.Fatalf("ParseMoney(%q): unexpected error: %v", tt.in, err)
+		}
+		if m.String() != tt.want {
+			t.Errorf("ParseMoney(%q): expected %s, got %s", tt.in, tt.want, m)
+		}
+	}
+
+	if _, err := ParseMoney("12.345 EUR"); !errors.Is(err, ErrPrecision) {
+		t.Errorf("expected precision error, got %v", err)
+	}
+	for _, in := range []string{"", "12.30", "12.30 EURO", "(12.30)", "abc EUR"} {
+		if _, err := ParseMoney(in); err == nil {
+			t.Errorf("ParseMoney(%q): expected error", in)
+		}
+	}
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	a := NewMoney(MustParseDecimal("10.005"), "eur")
+	if a.String() != "10.00 EUR" {
+		t.Errorf("expected half-even rounding to 10.00 EUR, got %s", a)
+	}
+
+	b := NewMoney(MustParseDecimal("2.5"), "EUR")
+
+	sum, err := a.Add(b)
+	if err != nil || sum.String() != "12.50 EUR" {
+		t.Errorf("Add: expected 12.50 EUR, got %s err=%v", sum, err)
+	}
+	diff, err := a.Sub(b)
+	if err != nil || diff.String() != "7.50 EUR" {
+		t.Errorf("Sub: expected 7.50 EUR, got %s err=%v", diff, err)
+	}
+	if got := b.Mul(MustParseDecimal("0.19")).String(); got != "0.48 EUR" {
+		t.Errorf("Mul: expected 0.48 EUR, got %s", got)
+	}
+	if c, err := a.Cmp(b); err != nil || c != 1 {
+		t.Errorf("Cmp: expected 1, got %d err=%v", c, err)
+	}
+
+	usd := NewMoney(NewDecimal(1, 0), "USD")
+	if _, err := a.Add(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("expected currency mismatch, got %v", err)
+	}
+	if _, err := a.Sub(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("expected currency mismatch, got %v", err)
+	}
+	if _, err := a.Cmp(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("expected currency mismatch, got %v", err)
+	}
+}
+
+func TestNullMoney_ValueScan(t *testing.T) {
+	nm := NewNullMoney(NewMoney(NewDecimal(1230, 2), "EUR"), true)
+	got, err := nm.Value()
+	if err != nil || got != "12.30 EUR" {
+		t.Errorf("expected 12.30 EUR, got %v err=%v", got, err)
+	}
+
+	defer func(format MoneyFormat) { MoneyValueFormat = format }(MoneyValueFormat)
+	MoneyValueFormat = MoneyFormatComposite
+
+	got, err = nm.Value()
+	if err != nil || got != "(12.30,EUR)" {
+		t.Errorf("expected (12.30,EUR), got %v err=%v", got, err)
+	}
+
+	got, err = NullMoney{}.Value()
+	if err != nil || got != nil {
+		t.Errorf("expected nil, got %v err=%v", got, err)
+	}
+
+	var scanned NullMoney
+	if err := scanned.Scan([]byte("(12.30,EUR)")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !scanned.Valid || scanned.Money.String() != "12.30 EUR" {
+		t.Errorf("expected 12.30 EUR, got %+v", scanned)
+	}
+
+	if err := scanned.Scan("12.30"); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+	if err := scanned.Scan("0.001 USD"); !errors.Is(err, ErrPrecision) {
+		t.Errorf("expected precision error, got %v", err)
+	}
+	if err := scanned.Scan(12.3); !errors.Is(err, ErrUnsupportedSource) {
+		t.Errorf("expected unsupported source error, got %v", err)
+	}
+	if err := scanned.Scan(nil); err != nil || scanned.Valid {
+		t.Errorf("expected Valid=false for nil, got %+v err=%v", scanned, err)
+	}
+}
+
+func TestNullMoney_JSON(t *testing.T) {
+	nm := NewNullMoney(NewMoney(NewDecimal(1230, 2), "EUR"), true)
+
+	data, err := json.Marshal(nm)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `{"amount":"12.30","currency":"EUR"}` {
+		t.Errorf("unexpected JSON %s", data)
+	}
+
+	for _, in := range []string{`{"amount":"12.30","currency":"EUR"}`, `{"amount":12.3,"currency":"eur"}`} {
+		var decoded NullMoney
+		if err := json.Unmarshal([]byte(in), &decoded); err != nil {
+			t.Fatalf("unmarshal %s: %v", in, err)
+		}
+		if !decoded.Valid || decoded.Money.String() != "12.30 EUR" {
+			t.Errorf("unmarshal %s: got %+v", in, decoded)
+		}
+	}
+
+	var decoded NullMoney
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded.Valid {
+		t.Errorf("expected Valid=false for null, got %+v err=%v", decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`{"amount":"1","currency":"EURO"}`), &decoded); err == nil {
+		t.Error("expected error for invalid currency")
+	}
+	if err := json.Unmarshal([]byte(`{"amount":"1.5","currency":"JPY"}`), &decoded); !errors.Is(err, ErrPrecision) {
+		t.Errorf("expected precision error, got %v", err)
+	}
+}
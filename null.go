@@ -0,0 +1,205 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"strconv"
+
+	"github.com/goccy/go-json"
+)
+
+// Null is a generic nullable wrapper for any type T.
+//
+// When T implements driver.Valuer, sql.Scanner, json.Marshaler or
+// json.Unmarshaler, the corresponding method of Null delegates to T.
+// Otherwise values are converted based on the underlying kind of T, so a
+// domain type such as `type OrderStatus string` works out of the box.
+type Null[T any] struct {
+	V     T
+	Valid bool
+}
+
+// Value method
+func (n Null[T]) Value() (driver.Value, error) {
+
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
+// Scan method
+func (n *Null[T]) Scan(value interface{}) error {
+
+	if value == nil {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+
+	if scanner, ok := interface{}(&n.V).(sql.Scanner); ok {
+		err := scanner.Scan(value)
+		if err != nil {
+			return NewScanError(nullTypeName[T](), value, err)
+		}
+
+		n.Valid = true
+
+		return nil
+	}
+
+	// Drivers reuse the memory of []byte sources after Scan returns.
+	if b, ok := value.([]byte); ok {
+		value = bytes.Clone(b)
+	}
+
+	if v, ok := value.(T); ok {
+		n.V, n.Valid = v, true
+		return nil
+	}
+
+	err := convertKind(reflect.ValueOf(value), reflect.ValueOf(&n.V).Elem())
+	if err != nil {
+		return NewScanError(nullTypeName[T](), value, err)
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// MarshalJSON method
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+
+	if !n.Valid {
+		return NullType, nil
+	}
+
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON method
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var res T
+
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		return err
+	}
+
+	*n = Null[T]{V: res, Valid: true}
+
+	return nil
+}
+
+// nullTypeName returns the name of Null[T] used in scan errors.
+func nullTypeName[T any]() string {
+	return "Null[" + reflect.TypeOf((*T)(nil)).Elem().String() + "]"
+}
+
+// convertKind stores src into dst when both share the same basic kind
+// (string, bool, signed, unsigned or float) or when src is textual and can
+// be parsed into the kind of dst.
+func convertKind(src, dst reflect.Value) error {
+
+	if b, ok := src.Interface().([]byte); ok {
+		if dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8 {
+			dst.SetBytes(b)
+			return nil
+		}
+
+		src = reflect.ValueOf(string(b))
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		if src.Kind() != reflect.String {
+			return ErrUnsupportedSource
+		}
+		dst.SetString(src.String())
+
+	case reflect.Bool:
+		switch src.Kind() {
+		case reflect.Bool:
+			dst.SetBool(src.Bool())
+		case reflect.String:
+			b, err := strconv.ParseBool(src.String())
+			if err != nil {
+				return wrapParseError(err)
+			}
+			dst.SetBool(b)
+		default:
+			return ErrUnsupportedSource
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		switch src.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i = src.Int()
+		case reflect.String:
+			v, err := strconv.ParseInt(src.String(), 10, 64)
+			if err != nil {
+				return wrapParseError(err)
+			}
+			i = v
+		default:
+			return ErrUnsupportedSource
+		}
+		if dst.OverflowInt(i) {
+			return ErrOverflow
+		}
+		dst.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		switch src.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			u = src.Uint()
+		case reflect.String:
+			v, err := strconv.ParseUint(src.String(), 10, 64)
+			if err != nil {
+				return wrapParseError(err)
+			}
+			u = v
+		default:
+			return ErrUnsupportedSource
+		}
+		if dst.OverflowUint(u) {
+			return ErrOverflow
+		}
+		dst.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		switch src.Kind() {
+		case reflect.Float32, reflect.Float64:
+			f = src.Float()
+		case reflect.String:
+			v, err := strconv.ParseFloat(src.String(), 64)
+			if err != nil {
+				return wrapParseError(err)
+			}
+			f = v
+		default:
+			return ErrUnsupportedSource
+		}
+		if dst.OverflowFloat(f) {
+			return ErrOverflow
+		}
+		dst.SetFloat(f)
+
+	default:
+		return ErrUnsupportedSource
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package nullish
+
+import (
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+)
+
+type orderStatus string
+
+type priority int16
+
+func TestNull_Value(t *testing.T) {
+	n := NewNull(orderStatus("paid"), true)
+	got, err := n.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "paid" {
+		t.Errorf("expected 'paid', got %v", got)
+	}
+
+	// Delegates to driver.Valuer
+	id := uuid.New()
+	got, err = NewNull(id, true).Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != id.String() {
+		t.Errorf("expected %v, got %v", id.String(), got)
+	}
+
+	n = NewNull(orderStatus(""), false)
+	got, err = n.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
+
+func TestNull_Scan(t *testing.T) {
+	var ns Null[orderStatus]
+
+	err := ns.Scan("shipped")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ns.V != "shipped" || !ns.Valid {
+		t.Errorf("expected V=shipped Valid=true, got V=%q Valid=%v", ns.V, ns.Valid)
+	}
+
+	err = ns.Scan([]byte("paid"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ns.V != "paid" {
+		t.Errorf("expected V=paid, got V=%q", ns.V)
+	}
+
+	err = ns.Scan(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ns.Valid || ns.V != "" {
+		t.Errorf("expected zero value for nil, got %+v", ns)
+	}
+
+	err = ns.Scan(42)
+	if err == nil {
+		t.Error("expected error for invalid type")
+	}
+
+	var np Null[priority]
+
+	err = np.Scan(int64(3))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if np.V != 3 || !np.Valid {
+		t.Errorf("expected V=3 Valid=true, got %+v", np)
+	}
+
+	err = np.Scan(int64(1 << 20))
+	if err == nil {
+		t.Error("expected error for overflow")
+	}
+
+	// Delegates to sql.Scanner
+	id := uuid.New()
+	var nu Null[uuid.UUID]
+
+	err = nu.Scan(id.String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nu.V != id || !nu.Valid {
+		t.Errorf("expected V=%v Valid=true, got %+v", id, nu)
+	}
+
+	// []byte sources are copied, the driver reuses their memory
+	src := []byte("abc")
+
+	var nb Null[[]byte]
+	if err := nb.Scan(src); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ob Optional[[]byte]
+	if err := ob.Scan(src); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var na Null[interface{}]
+	if err := na.Scan(src); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	copy(src, "xyz")
+	if string(nb.V) != "abc" || string(ob.V) != "abc" || string(na.V.([]byte)) != "abc" {
+		t.Errorf("expected copies of abc, got %q %q %q", nb.V, ob.V, na.V)
+	}
+}
+
+func TestNull_JSON(t *testing.T) {
+	n := NewNull(orderStatus("paid"), true)
+	data, err := json.Marshal(n)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `"paid"` {
+		t.Errorf("expected \"paid\", got %s", data)
+	}
+
+	var decoded Null[orderStatus]
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if decoded != n {
+		t.Errorf("roundtrip failed: expected %+v, got %+v", n, decoded)
+	}
+
+	data, err = json.Marshal(NewNull(orderStatus(""), false))
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != "null" {
+		t.Errorf("expected null, got %s", data)
+	}
+
+	err = json.Unmarshal([]byte("null"), &decoded)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if decoded.Valid {
+		t.Error("expected Valid=false for null")
+	}
+}
+
+func BenchmarkNull_Scan(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var n Null[orderStatus]
+		_ = n.Scan("benchmark")
+	}
+}
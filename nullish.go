@@ -1,6 +1,7 @@
 package nullish
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/goccy/go-json"
@@ -10,6 +11,10 @@ import (
 
 var NullType = []byte("null")
 
+// NullText is the token MarshalText emits for, and UnmarshalText reads as, a
+// null value. It defaults to the empty string.
+var NullText = []byte("")
+
 func NewNullFloat(float float64, valid bool) NullFloat {
 	return NullFloat{
 		Float: float,
@@ -80,9 +85,199 @@ func NewNullUUID(uuid uuid.UUID, valid bool) NullUUID {
 	}
 }
 
+// NewNullUUIDv4 returns a valid NullUUID holding a new random UUID. It
+// panics if the random source fails, like uuid.New.
+func NewNullUUIDv4() NullUUID {
+	return NewNullUUID(uuid.New(), true)
+}
+
+// NewNullUUIDv7 returns a valid NullUUID holding a new time-ordered version
+// 7 UUID. It panics if the random source fails.
+func NewNullUUIDv7() NullUUID {
+	return NewNullUUID(uuid.Must(uuid.NewV7()), true)
+}
+
+func NewNullUUIDBinary(uuid uuid.UUID, valid bool) NullUUIDBinary {
+	return NullUUIDBinary{
+		UUID:  uuid,
+		Valid: valid,
+	}
+}
+
 func NewNullULID(ulid ulid.ULID, valid bool) NullULID {
 	return NullULID{
 		ULID:  ulid,
 		Valid: valid,
 	}
 }
+
+func NewNull[T any](value T, valid bool) Null[T] {
+	return Null[T]{
+		V:     value,
+		Valid: valid,
+	}
+}
+
+func NewOptional[T any](value T, valid bool) Optional[T] {
+	return Optional[T]{
+		V:     value,
+		Valid: valid,
+		Set:   true,
+	}
+}
+
+func NewNullJSONOf[T any](value T, valid bool) NullJSONOf[T] {
+	return NullJSONOf[T]{
+		V:     value,
+		Valid: valid,
+	}
+}
+
+func NewNullInt8(integer int8, valid bool) NullInt8 {
+	return NullInt8{
+		Int8:  integer,
+		Valid: valid,
+	}
+}
+
+func NewNullInt16(integer int16, valid bool) NullInt16 {
+	return NullInt16{
+		Int16: integer,
+		Valid: valid,
+	}
+}
+
+func NewNullInt32(integer int32, valid bool) NullInt32 {
+	return NullInt32{
+		Int32: integer,
+		Valid: valid,
+	}
+}
+
+func NewNullInt64(integer int64, valid bool) NullInt64 {
+	return NullInt64{
+		Int64: integer,
+		Valid: valid,
+	}
+}
+
+func NewNullUint8(integer uint8, valid bool) NullUint8 {
+	return NullUint8{
+		Uint8: integer,
+		Valid: valid,
+	}
+}
+
+func NewNullUint16(integer uint16, valid bool) NullUint16 {
+	return NullUint16{
+		Uint16: integer,
+		Valid:  valid,
+	}
+}
+
+func NewNullUint32(integer uint32, valid bool) NullUint32 {
+	return NullUint32{
+		Uint32: integer,
+		Valid:  valid,
+	}
+}
+
+func NewNullUint64(integer uint64, valid bool) NullUint64 {
+	return NullUint64{
+		Uint64: integer,
+		Valid:  valid,
+	}
+}
+
+func NewNullDecimal(decimal Decimal, valid bool) NullDecimal {
+	return NullDecimal{
+		Decimal: decimal,
+		Valid:   valid,
+	}
+}
+
+func NewNullBigInt(integer *big.Int, valid bool) NullBigInt {
+	return NullBigInt{
+		BigInt: integer,
+		Valid:  valid,
+	}
+}
+
+func NewNullMoney(money Money, valid bool) NullMoney {
+	return NullMoney{
+		Money: money,
+		Valid: valid,
+	}
+}
+
+func NewNullDate(date Date, valid bool) NullDate {
+	return NullDate{
+		Date:  date,
+		Valid: valid,
+	}
+}
+
+func NewNullTimeOfDay(timeOfDay TimeOfDay, valid bool) NullTimeOfDay {
+	return NullTimeOfDay{
+		TimeOfDay: timeOfDay,
+		Valid:     valid,
+	}
+}
+
+func NewNullTimeOfDayTZ(timeOfDay TimeOfDay, offset int, valid bool) NullTimeOfDayTZ {
+	return NullTimeOfDayTZ{
+		TimeOfDay: timeOfDay,
+		Offset:    offset,
+		Valid:     valid,
+	}
+}
+
+func NewNullDuration(duration time.Duration, valid bool) NullDuration {
+	return NullDuration{
+		Duration: duration,
+		Valid:    valid,
+	}
+}
+
+func NewNullInterval(months int32, days int32, microseconds int64, valid bool) NullInterval {
+	return NullInterval{
+		Months:       months,
+		Days:         days,
+		Microseconds: microseconds,
+		Valid:        valid,
+	}
+}
+
+func NewNullUnixTime(time time.Time, valid bool) NullUnixTime {
+	return NullUnixTime{
+		Time:  time,
+		Valid: valid,
+	}
+}
+
+func NewNullUnixMilli(time time.Time, valid bool) NullUnixMilli {
+	return NullUnixMilli{
+		Time:  time,
+		Valid: valid,
+	}
+}
+
+func NewNullUnixNano(time time.Time, valid bool) NullUnixNano {
+	return NullUnixNano{
+		Time:  time,
+		Valid: valid,
+	}
+}
+
+func NewNullTimeAs[F TimeFormatter](time time.Time, valid bool) NullTimeAs[F] {
+	return NullTimeAs[F]{
+		NullTime: NewNullTime(time, valid),
+	}
+}
+
+func NewNullTimeIn(time time.Time, location *time.Location, valid bool) NullTimeIn {
+	return NullTimeIn{
+		NullTime: NewNullTime(time, valid),
+		Location: location,
+	}
+}
@@ -1,9 +1,7 @@
 package nullish
 
 import (
-	"bytes"
 	"database/sql/driver"
-	"errors"
 
 	"github.com/goccy/go-json"
 )
@@ -31,41 +29,54 @@ func (no *NullObj) Scan(value interface{}) error {
 		return nil
 	}
 
-	b, ok := value.(map[string]interface{})
-	if !ok {
-		return errors.New("type assertion to object is failed")
-	}
+	switch t := value.(type) {
+	case map[string]interface{}:
+		no.Obj, no.Valid = t, true
+
+	case []byte:
+		return no.decode(value, t)
+
+	case json.RawMessage:
+		return no.decode(value, t)
 
-	no.Obj, no.Valid = b, true
+	case string:
+		return no.decode(value, []byte(t))
+
+	default:
+		return unsupportedSourceError("NullObj", value)
+	}
 
 	return nil
 }
 
 // MarshalJSON method
 func (no NullObj) MarshalJSON() ([]byte, error) {
-
-	if !no.Valid {
-		return NullType, nil
-	}
-
-	return json.Marshal(no.Obj)
+	return NewNull(no.Obj, no.Valid).MarshalJSON()
 }
 
 // UnmarshalJSON method
 func (no *NullObj) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, NullType) {
-		*no = NullObj{}
-		return nil
+	var res Null[map[string]interface{}]
+
+	err := res.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
 
+	*no = NullObj{Obj: res.V, Valid: res.Valid}
+
+	return nil
+}
+
+func (no *NullObj) decode(value interface{}, data []byte) error {
 	var res map[string]interface{}
 
-	err := json.Unmarshal(data, &res)
+	valid, err := decodeJSON(data, '{', &res)
 	if err != nil {
-		return err
+		return parseError("NullObj", value, err)
 	}
 
-	*no = NullObj{Obj: res, Valid: true}
+	no.Obj, no.Valid = res, valid
 
 	return nil
 }
@@ -1,6 +1,8 @@
 ﻿package nullish
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/goccy/go-json"
@@ -136,6 +138,52 @@ func TestNullObj_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestNullObj_ScanJSON(t *testing.T) {
+	var no NullObj
+
+	// Scan JSON bytes as returned by drivers
+	err := no.Scan([]byte(`{"key":"value"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if no.Obj["key"] != "value" || !no.Valid {
+		t.Errorf("expected key=value Valid=true, got %+v", no)
+	}
+
+	// Scan JSON string
+	err = no.Scan(` {"num":42}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if no.Obj["num"] != float64(42) {
+		t.Errorf("expected num=42, got %v", no.Obj["num"])
+	}
+
+	// Scan json.RawMessage
+	err = no.Scan(json.RawMessage(`{"raw":true}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if no.Obj["raw"] != true {
+		t.Errorf("expected raw=true, got %v", no.Obj["raw"])
+	}
+
+	// Scan JSON null
+	err = no.Scan([]byte("null"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if no.Valid {
+		t.Error("expected Valid=false for json null")
+	}
+
+	// Scan mismatched shape
+	err = no.Scan([]byte(`[1,2]`))
+	if !errors.Is(err, ErrParse) || !strings.Contains(err.Error(), "json value is not an object") {
+		t.Errorf("expected shape error, got %v", err)
+	}
+}
+
 func BenchmarkNullObj_Value(b *testing.B) {
 	no := NewNullObj(map[string]interface{}{"key": "value"}, true)
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,154 @@
+package nullish
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strings"
+)
+
+// Optional is a tri-state nullable value meant for PATCH style payloads.
+//
+// Set reports whether the field was present in the decoded JSON at all,
+// while Valid reports whether it carried a non-null value:
+//
+//	field omitted        -> Set=false Valid=false
+//	"field": null        -> Set=true  Valid=false
+//	"field": <value>     -> Set=true  Valid=true
+//
+// Set is only flipped by UnmarshalJSON, which encoding/json never calls for
+// absent keys, and by the NewOptional constructor.
+type Optional[T any] struct {
+	V     T
+	Valid bool
+	Set   bool
+}
+
+// Presence is implemented by types that remember whether they were present
+// in a decoded payload.
+type Presence interface {
+	IsSet() bool
+}
+
+// IsSet reports whether the value was present in the decoded payload.
+func (o Optional[T]) IsSet() bool {
+	return o.Set
+}
+
+// IsZero reports whether the value was absent. Only encoding/json from Go
+// 1.24 consults it for the `omitzero` struct tag option; go-json and older
+// releases still encode unset fields.
+func (o Optional[T]) IsZero() bool {
+	return !o.Set
+}
+
+// Null returns the value without its presence information.
+func (o Optional[T]) Null() Null[T] {
+	return Null[T]{V: o.V, Valid: o.Valid}
+}
+
+// Value method
+func (o Optional[T]) Value() (driver.Value, error) {
+	return o.Null().Value()
+}
+
+// Scan method
+func (o *Optional[T]) Scan(value interface{}) error {
+	res := o.Null()
+
+	err := res.Scan(value)
+	if err != nil {
+		return err
+	}
+
+	o.V, o.Valid = res.V, res.Valid
+
+	return nil
+}
+
+// MarshalJSON method
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	return o.Null().MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	var res Null[T]
+
+	err := res.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	*o = Optional[T]{V: res.V, Valid: res.Valid, Set: true}
+
+	return nil
+}
+
+// PresentFields returns the JSON names of every Presence field of the struct
+// pointed to by v that was present in the decoded payload. Fields of embedded
+// structs are included, and names follow the `json` struct tag.
+func PresentFields(v interface{}) []string {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []string
+
+	collectPresentFields(rv, &fields)
+
+	return fields
+}
+
+var presenceType = reflect.TypeOf((*Presence)(nil)).Elem()
+
+func collectPresentFields(rv reflect.Value, fields *[]string) {
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() && !sf.Anonymous {
+			continue
+		}
+
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		fv := rv.Field(i)
+
+		if sf.IsExported() && fv.Type().Implements(presenceType) {
+			if fv.Interface().(Presence).IsSet() {
+				if name == "" {
+					name = sf.Name
+				}
+				*fields = append(*fields, name)
+			}
+			continue
+		}
+
+		if sf.Anonymous && name == "" && fv.Kind() == reflect.Struct {
+			collectPresentFields(fv, fields)
+		}
+	}
+}
+
+// IsPresent reports whether the JSON field name is among the present fields
+// of the struct pointed to by v.
+func IsPresent(v interface{}, name string) bool {
+	for _, field := range PresentFields(v) {
+		if field == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,101 @@
+package nullish
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+type patchUser struct {
+	Name  Optional[string] `json:"name"`
+	Email Optional[string] `json:"email,omitempty"`
+	Age   Optional[int]    `json:"age"`
+	patchAudit
+	Note Optional[string]
+}
+
+type patchAudit struct {
+	Reason Optional[string] `json:"reason"`
+}
+
+func TestOptional_UnmarshalJSON(t *testing.T) {
+	var p patchUser
+	err := json.Unmarshal([]byte(`{"name":"John","email":null}`), &p)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !p.Name.Set || !p.Name.Valid || p.Name.V != "John" {
+		t.Errorf("expected name set and valid, got %+v", p.Name)
+	}
+	if !p.Email.Set || p.Email.Valid {
+		t.Errorf("expected email set and null, got %+v", p.Email)
+	}
+	if p.Age.Set || p.Age.Valid {
+		t.Errorf("expected age absent, got %+v", p.Age)
+	}
+}
+
+func TestOptional_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(NewOptional("John", true))
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `"John"` {
+		t.Errorf("expected \"John\", got %s", data)
+	}
+
+	data, err = json.Marshal(Optional[string]{})
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != "null" {
+		t.Errorf("expected null, got %s", data)
+	}
+}
+
+func TestOptional_ValueScan(t *testing.T) {
+	o := NewOptional(42, true)
+	got, err := o.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != int64(42) {
+		t.Errorf("expected 42, got %v", got)
+	}
+
+	var scanned Optional[int]
+	err = scanned.Scan(int64(7))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scanned.V != 7 || !scanned.Valid || scanned.Set {
+		t.Errorf("expected V=7 Valid=true Set=false, got %+v", scanned)
+	}
+}
+
+func TestPresentFields(t *testing.T) {
+	var p patchUser
+	err := json.Unmarshal([]byte(`{"email":null,"age":3,"reason":"typo","Note":"x"}`), &p)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	got := PresentFields(&p)
+	expected := []string{"email", "age", "reason", "Note"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if IsPresent(&p, "name") {
+		t.Error("expected name to be absent")
+	}
+	if !IsPresent(p, "age") {
+		t.Error("expected age to be present")
+	}
+
+	if PresentFields(nil) != nil {
+		t.Error("expected nil for nil input")
+	}
+}
@@ -0,0 +1,96 @@
+package pgxnullish
+
+import (
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// codec wraps a builtin pgtype.Codec and converts nullish values and targets
+// into adapters implementing the pgtype valuer and scanner interfaces before
+// delegating to it.
+type codec struct {
+	pgtype.Codec
+	json bool
+}
+
+// PlanEncode method
+func (c *codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+
+	if c.json {
+		if _, _, ok := jsonValue(value); ok {
+			if plan := c.Codec.PlanEncode(m, oid, format, json.RawMessage(nil)); plan != nil {
+				return &jsonEncodePlan{next: plan}
+			}
+		}
+
+		return c.Codec.PlanEncode(m, oid, format, value)
+	}
+
+	if wrapped, ok := wrapValue(value); ok {
+		if plan := c.Codec.PlanEncode(m, oid, format, wrapped); plan != nil {
+			return &encodePlan{next: plan}
+		}
+	}
+
+	return c.Codec.PlanEncode(m, oid, format, value)
+}
+
+// PlanScan method
+func (c *codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+
+	wrap := wrapTarget
+	if c.json {
+		wrap = wrapJSONTarget
+	}
+
+	if wrapped, ok := wrap(target); ok {
+		if plan := c.Codec.PlanScan(m, oid, format, wrapped); plan != nil {
+			return &scanPlan{next: plan, wrap: wrap}
+		}
+	}
+
+	return c.Codec.PlanScan(m, oid, format, target)
+}
+
+type encodePlan struct {
+	next pgtype.EncodePlan
+}
+
+// Encode method
+func (p *encodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	wrapped, _ := wrapValue(value)
+
+	return p.next.Encode(wrapped, buf)
+}
+
+type jsonEncodePlan struct {
+	next pgtype.EncodePlan
+}
+
+// Encode method
+func (p *jsonEncodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	marshaler, valid, _ := jsonValue(value)
+	if !valid {
+		return nil, nil
+	}
+
+	data, err := marshaler.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.next.Encode(json.RawMessage(data), buf)
+}
+
+type scanPlan struct {
+	next pgtype.ScanPlan
+	wrap func(target any) (any, bool)
+}
+
+// Scan method
+func (p *scanPlan) Scan(src []byte, target any) error {
+	wrapped, _ := p.wrap(target)
+
+	return p.next.Scan(src, wrapped)
+}
@@ -0,0 +1,14 @@
+module github.com/sutantodadang/nullish/pgxnullish
+
+go 1.21
+
+require (
+	github.com/google/uuid v1.6.0
+	github.com/jackc/pgx/v5 v5.7.4
+	github.com/oklog/ulid/v2 v2.1.1
+	github.com/sutantodadang/nullish v0.0.0-00010101000000-000000000000
+)
+
+require github.com/goccy/go-json v0.10.5 // indirect
+
+replace github.com/sutantodadang/nullish => ../
@@ -0,0 +1,87 @@
+// Package pgxnullish provides native pgx v5 codecs for the nullish types.
+//
+// Without registration pgx falls back to the driver.Valuer and sql.Scanner
+// implementations of the nullish types, which forces the text protocol and
+// fails on some binary formats such as jsonb. Register wraps the codecs of
+// the PostgreSQL types backing the nullish types so they are encoded and
+// scanned directly through the binary protocol:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		pgxnullish.Register(conn.TypeMap())
+//		return nil
+//	}
+//
+// The generic nullish.Null and nullish.Optional types are not covered and
+// keep using the database/sql path.
+package pgxnullish
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/sutantodadang/nullish"
+)
+
+// typeNames lists the PostgreSQL types whose codecs are wrapped by Register.
+var typeNames = []string{
+	"text", "varchar", "bpchar", "name",
+	"int2", "int4", "int8",
+	"float4", "float8", "numeric",
+	"bool",
+	"timestamptz", "timestamp", "date", "time", "interval",
+	"uuid",
+	"json", "jsonb",
+}
+
+// Register registers the nullish types on m. It is safe to call more than
+// once on the same Map.
+func Register(m *pgtype.Map) {
+	for _, name := range typeNames {
+		t, ok := m.TypeForName(name)
+		if !ok {
+			continue
+		}
+
+		if _, ok := t.Codec.(*codec); ok {
+			continue
+		}
+
+		m.RegisterType(&pgtype.Type{
+			Name:  t.Name,
+			OID:   t.OID,
+			Codec: &codec{Codec: t.Codec, json: name == "json" || name == "jsonb"},
+		})
+	}
+
+	registerDefaultPgType(m, nullish.NullString{}, &nullish.NullString{}, "text")
+	registerDefaultPgType(m, nullish.NullInt{}, &nullish.NullInt{}, "int8")
+	registerDefaultPgType(m, nullish.NullInt8{}, &nullish.NullInt8{}, "int2")
+	registerDefaultPgType(m, nullish.NullInt16{}, &nullish.NullInt16{}, "int2")
+	registerDefaultPgType(m, nullish.NullInt32{}, &nullish.NullInt32{}, "int4")
+	registerDefaultPgType(m, nullish.NullInt64{}, &nullish.NullInt64{}, "int8")
+	registerDefaultPgType(m, nullish.NullUint8{}, &nullish.NullUint8{}, "int2")
+	registerDefaultPgType(m, nullish.NullUint16{}, &nullish.NullUint16{}, "int4")
+	registerDefaultPgType(m, nullish.NullUint32{}, &nullish.NullUint32{}, "int8")
+	registerDefaultPgType(m, nullish.NullUint64{}, &nullish.NullUint64{}, "numeric")
+	registerDefaultPgType(m, nullish.NullFloat{}, &nullish.NullFloat{}, "float8")
+	registerDefaultPgType(m, nullish.NullDecimal{}, &nullish.NullDecimal{}, "numeric")
+	registerDefaultPgType(m, nullish.NullBigInt{}, &nullish.NullBigInt{}, "numeric")
+	registerDefaultPgType(m, nullish.NullBool{}, &nullish.NullBool{}, "bool")
+	registerDefaultPgType(m, nullish.NullTime{}, &nullish.NullTime{}, "timestamptz")
+	registerDefaultPgType(m, nullish.NullUnixTime{}, &nullish.NullUnixTime{}, "timestamptz")
+	registerDefaultPgType(m, nullish.NullUnixMilli{}, &nullish.NullUnixMilli{}, "timestamptz")
+	registerDefaultPgType(m, nullish.NullUnixNano{}, &nullish.NullUnixNano{}, "timestamptz")
+	registerDefaultPgType(m, nullish.NullDate{}, &nullish.NullDate{}, "date")
+	registerDefaultPgType(m, nullish.NullTimeOfDay{}, &nullish.NullTimeOfDay{}, "time")
+	registerDefaultPgType(m, nullish.NullDuration{}, &nullish.NullDuration{}, "interval")
+	registerDefaultPgType(m, nullish.NullInterval{}, &nullish.NullInterval{}, "interval")
+	registerDefaultPgType(m, nullish.NullUUID{}, &nullish.NullUUID{}, "uuid")
+	registerDefaultPgType(m, nullish.NullULID{}, &nullish.NullULID{}, "text")
+	registerDefaultPgType(m, nullish.NullJSON{}, &nullish.NullJSON{}, "jsonb")
+	registerDefaultPgType(m, nullish.NullObj{}, &nullish.NullObj{}, "jsonb")
+	registerDefaultPgType(m, nullish.NullArr{}, &nullish.NullArr{}, "jsonb")
+	registerDefaultPgType(m, nullish.NullArrObj{}, &nullish.NullArrObj{}, "jsonb")
+}
+
+func registerDefaultPgType(m *pgtype.Map, value, pointer any, name string) {
+	m.RegisterDefaultPgType(value, name)
+	m.RegisterDefaultPgType(pointer, name)
+}
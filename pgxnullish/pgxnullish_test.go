@@ -0,0 +1,419 @@
+package pgxnullish
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oklog/ulid/v2"
+	"github.com/sutantodadang/nullish"
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	Register(m)
+	return m
+}
+
+var fixtureUUID = uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+func TestRegister_Encode(t *testing.T) {
+	m := newMap()
+
+	tests := []struct {
+		name   string
+		oid    uint32
+		format int16
+		value  any
+		want   []byte
+	}{
+		{"text", pgtype.TextOID, pgtype.BinaryFormatCode, nullish.NewNullString("hello", true), []byte("hello")},
+		{"int8", pgtype.Int8OID, pgtype.BinaryFormatCode, nullish.NewNullInt(42, true), []byte{0, 0, 0, 0, 0, 0, 0, 42}},
+		{"int4", pgtype.Int4OID, pgtype.BinaryFormatCode, nullish.NewNullInt(-1, true), []byte{0xff, 0xff, 0xff, 0xff}},
+		{"int2 sized", pgtype.Int2OID, pgtype.BinaryFormatCode, nullish.NewNullInt16(258, true), []byte{1, 2}},
+		{"int4 sized", pgtype.Int4OID, pgtype.BinaryFormatCode, nullish.NewNullInt32(-2, true), []byte{0xff, 0xff, 0xff, 0xfe}},
+		{"int8 text", pgtype.Int8OID, pgtype.TextFormatCode, nullish.NewNullInt(42, true), []byte("42")},
+		{"int2 unsigned", pgtype.Int2OID, pgtype.BinaryFormatCode, nullish.NewNullUint8(255, true), []byte{0, 0xff}},
+		{"int4 unsigned", pgtype.Int4OID, pgtype.BinaryFormatCode, nullish.NewNullUint16(65535, true), []byte{0, 0, 0xff, 0xff}},
+		{"int8 unsigned", pgtype.Int8OID, pgtype.BinaryFormatCode, nullish.NewNullUint32(math.MaxUint32, true), []byte{0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff}},
+		{"numeric unsigned", pgtype.NumericOID, pgtype.TextFormatCode, nullish.NewNullUint64(math.MaxUint64, true), []byte("18446744073709551615")},
+		{"float8", pgtype.Float8OID, pgtype.BinaryFormatCode, nullish.NewNullFloat(1.5, true), []byte{0x3f, 0xf8, 0, 0, 0, 0, 0, 0}},
+		{"bool", pgtype.BoolOID, pgtype.BinaryFormatCode, nullish.NewNullBool(true, true), []byte{1}},
+		{"numeric", pgtype.NumericOID, pgtype.BinaryFormatCode, nullish.NewNullDecimal(nullish.NewDecimal(1230, 2), true), []byte{0, 2, 0, 0, 0, 0, 0, 2, 0, 12, 0x0b, 0xb8}},
+		{"numeric bigint", pgtype.NumericOID, pgtype.TextFormatCode, nullish.NewNullBigInt(new(big.Int).Lsh(big.NewInt(1), 100), true), []byte("1267650600228229401496703205376")},
+		{"numeric text", pgtype.NumericOID, pgtype.TextFormatCode, nullish.NewNullDecimal(nullish.MustParseDecimal("-0.000123"), true), []byte("-0.000123")},
+		{"timestamptz", pgtype.TimestamptzOID, pgtype.BinaryFormatCode, nullish.NewNullTime(time.Date(2000, 1, 1, 0, 0, 1, 0, time.UTC), true), []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x40}},
+		{"timestamptz unix", pgtype.TimestamptzOID, pgtype.BinaryFormatCode, nullish.NewNullUnixMilli(time.Date(2000, 1, 1, 0, 0, 1, 0, time.UTC), true), []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x40}},
+		{"date", pgtype.DateOID, pgtype.BinaryFormatCode, nullish.NewNullTime(time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC), true), []byte{0, 0, 0, 1}},
+		{"date civil", pgtype.DateOID, pgtype.BinaryFormatCode, nullish.NewNullDate(nullish.Date{Year: 2000, Month: 1, Day: 2}, true), []byte{0, 0, 0, 1}},
+		{"time", pgtype.TimeOID, pgtype.TextFormatCode, nullish.NewNullTimeOfDay(nullish.TimeOfDay{Hour: 15, Minute: 4, Second: 5, Microsecond: 120000}, true), []byte("15:04:05.120000")},
+		{"interval", pgtype.IntervalOID, pgtype.BinaryFormatCode, nullish.NewNullDuration(time.Second+time.Microsecond, true), []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x41, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{"interval months", pgtype.IntervalOID, pgtype.TextFormatCode, nullish.NewNullInterval(14, -3, 1500000, true), []byte("14 mon -3 day 00:00:01.500000")},
+		{"duration int8", pgtype.Int8OID, pgtype.TextFormatCode, nullish.NewNullDuration(time.Second, true), []byte("1000000000")},
+		{"uuid", pgtype.UUIDOID, pgtype.BinaryFormatCode, nullish.NewNullUUID(fixtureUUID, true), fixtureUUID[:]},
+		{"ulid", pgtype.UUIDOID, pgtype.BinaryFormatCode, nullish.NewNullULID(ulid.ULID(fixtureUUID), true), fixtureUUID[:]},
+		{"jsonb obj", pgtype.JSONBOID, pgtype.BinaryFormatCode, nullish.NewNullObj(map[string]interface{}{"a": 1}, true), []byte("\x01{\"a\":1}")},
+		{"jsonb arr", pgtype.JSONBOID, pgtype.BinaryFormatCode, nullish.NewNullArr([]interface{}{1, "b"}, true), []byte("\x01[1,\"b\"]")},
+		{"json raw", pgtype.JSONOID, pgtype.TextFormatCode, nullish.NewNullJSON([]byte(`{"a":1}`), true), []byte(`{"a":1}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.Encode(tt.oid, tt.format, tt.value, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("expected %x, got %x", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRegister_EncodeNull(t *testing.T) {
+	m := newMap()
+
+	tests := []struct {
+		name  string
+		oid   uint32
+		value any
+	}{
+		{"text", pgtype.TextOID, nullish.NullString{}},
+		{"int8", pgtype.Int8OID, nullish.NullInt{}},
+		{"timestamptz", pgtype.TimestamptzOID, nullish.NullTime{}},
+		{"uuid", pgtype.UUIDOID, nullish.NullUUID{}},
+		{"jsonb", pgtype.JSONBOID, nullish.NullObj{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.Encode(tt.oid, pgtype.BinaryFormatCode, tt.value, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != nil {
+				t.Errorf("expected nil, got %x", got)
+			}
+		})
+	}
+}
+
+func TestRegister_Scan(t *testing.T) {
+	m := newMap()
+
+	var ns nullish.NullString
+	if err := m.Scan(pgtype.TextOID, pgtype.BinaryFormatCode, []byte("hello"), &ns); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ns.String != "hello" || !ns.Valid {
+		t.Errorf("expected String=hello Valid=true, got %+v", ns)
+	}
+
+	var ni nullish.NullInt
+	if err := m.Scan(pgtype.Int4OID, pgtype.BinaryFormatCode, []byte{0, 0, 0, 7}, &ni); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ni.Int != 7 || !ni.Valid {
+		t.Errorf("expected Int=7 Valid=true, got %+v", ni)
+	}
+
+	var n8 nullish.NullInt8
+	if err := m.Scan(pgtype.Int2OID, pgtype.BinaryFormatCode, []byte{1, 0}, &n8); !errors.Is(err, nullish.ErrOverflow) {
+		t.Errorf("expected overflow error for 256 into NullInt8, got %v", err)
+	}
+
+	var nu8 nullish.NullUint8
+	if err := m.Scan(pgtype.Int2OID, pgtype.BinaryFormatCode, []byte{0xff, 0xff}, &nu8); !errors.Is(err, nullish.ErrOverflow) {
+		t.Errorf("expected overflow error for -1 into NullUint8, got %v", err)
+	}
+
+	var nu32 nullish.NullUint32
+	if err := m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, []byte{0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff}, &nu32); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nu32.Uint32 != math.MaxUint32 || !nu32.Valid {
+		t.Errorf("expected Uint32=MaxUint32 Valid=true, got %+v", nu32)
+	}
+
+	var nu64 nullish.NullUint64
+	if err := m.Scan(pgtype.NumericOID, pgtype.TextFormatCode, []byte("18446744073709551615"), &nu64); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nu64.Uint64 != math.MaxUint64 || !nu64.Valid {
+		t.Errorf("expected Uint64=MaxUint64 Valid=true, got %+v", nu64)
+	}
+	if err := m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, []byte{0, 0, 0, 0, 0, 0, 0, 42}, &nu64); err != nil || nu64.Uint64 != 42 {
+		t.Errorf("expected Uint64=42, got %+v err=%v", nu64, err)
+	}
+	if _, err := m.Encode(pgtype.Int8OID, pgtype.BinaryFormatCode, nullish.NewNullUint64(math.MaxUint64, true), nil); !errors.Is(err, nullish.ErrOverflow) {
+		t.Errorf("expected overflow error encoding MaxUint64 as int8, got %v", err)
+	}
+
+	var n64 nullish.NullInt64
+	if err := m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, []byte{0x80, 0, 0, 0, 0, 0, 0, 0}, &n64); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n64.Int64 != math.MinInt64 || !n64.Valid {
+		t.Errorf("expected Int64=MinInt64 Valid=true, got %+v", n64)
+	}
+
+	var nd nullish.NullDecimal
+	if err := m.Scan(pgtype.NumericOID, pgtype.BinaryFormatCode, []byte{0, 2, 0, 0, 0, 0, 0, 2, 0, 12, 0x0b, 0xb8}, &nd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nd.Decimal.String() != "12.30" || !nd.Valid {
+		t.Errorf("expected Decimal=12.30 Valid=true, got %+v", nd)
+	}
+
+	if err := m.Scan(pgtype.NumericOID, pgtype.TextFormatCode, []byte("NaN"), &nd); !errors.Is(err, nullish.ErrParse) {
+		t.Errorf("expected parse error for NaN, got %v", err)
+	}
+
+	var nbi nullish.NullBigInt
+	if err := m.Scan(pgtype.NumericOID, pgtype.TextFormatCode, []byte("1267650600228229401496703205376"), &nbi); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nbi.BigInt.Cmp(new(big.Int).Lsh(big.NewInt(1), 100)) != 0 || !nbi.Valid {
+		t.Errorf("expected BigInt=2^100 Valid=true, got %+v", nbi)
+	}
+
+	if err := m.Scan(pgtype.NumericOID, pgtype.BinaryFormatCode, []byte{0, 2, 0, 0, 0, 0, 0, 2, 0, 12, 0x0b, 0xb8}, &nbi); !errors.Is(err, nullish.ErrPrecision) {
+		t.Errorf("expected precision error for 12.30 into NullBigInt, got %v", err)
+	}
+
+	var ndate nullish.NullDate
+	if err := m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, []byte{0, 0, 0, 1}, &ndate); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ndate.Date != (nullish.Date{Year: 2000, Month: 1, Day: 2}) || !ndate.Valid {
+		t.Errorf("expected Date=2000-01-02 Valid=true, got %+v", ndate)
+	}
+
+	var ntod nullish.NullTimeOfDay
+	if err := m.Scan(pgtype.TimeOID, pgtype.BinaryFormatCode, []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x41}, &ntod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ntod.TimeOfDay != (nullish.TimeOfDay{Second: 1, Microsecond: 1}) || !ntod.Valid {
+		t.Errorf("expected TimeOfDay=00:00:01.000001 Valid=true, got %+v", ntod)
+	}
+
+	var ndur nullish.NullDuration
+	if err := m.Scan(pgtype.IntervalOID, pgtype.TextFormatCode, []byte("1 day 02:03:04.5"), &ndur); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ndur.Duration != 26*time.Hour+3*time.Minute+4500*time.Millisecond || !ndur.Valid {
+		t.Errorf("expected Duration=26h3m4.5s Valid=true, got %+v", ndur)
+	}
+
+	if err := m.Scan(pgtype.IntervalOID, pgtype.TextFormatCode, []byte("1 mon"), &ndur); !errors.Is(err, nullish.ErrOverflow) {
+		t.Errorf("expected overflow error for months, got %v", err)
+	}
+
+	var nint nullish.NullInterval
+	if err := m.Scan(pgtype.IntervalOID, pgtype.TextFormatCode, []byte("1 year 2 mons -3 days +00:00:01.5"), &nint); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nint != nullish.NewNullInterval(14, -3, 1500000, true) {
+		t.Errorf("expected Months=14 Days=-3 Microseconds=1500000, got %+v", nint)
+	}
+
+	var nunix nullish.NullUnixTime
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x40}, &nunix); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !nunix.Time.Equal(time.Date(2000, 1, 1, 0, 0, 1, 0, time.UTC)) || !nunix.Valid {
+		t.Errorf("expected Time=2000-01-01T00:00:01Z Valid=true, got %+v", nunix)
+	}
+
+	var nf nullish.NullFloat
+	if err := m.Scan(pgtype.Float8OID, pgtype.BinaryFormatCode, []byte{0x3f, 0xf8, 0, 0, 0, 0, 0, 0}, &nf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nf.Float != 1.5 || !nf.Valid {
+		t.Errorf("expected Float=1.5 Valid=true, got %+v", nf)
+	}
+
+	var nb nullish.NullBool
+	if err := m.Scan(pgtype.BoolOID, pgtype.BinaryFormatCode, []byte{1}, &nb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !nb.Bool || !nb.Valid {
+		t.Errorf("expected Bool=true Valid=true, got %+v", nb)
+	}
+
+	var nt nullish.NullTime
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x40}, &nt); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !nt.Time.Equal(time.Date(2000, 1, 1, 0, 0, 1, 0, time.UTC)) || !nt.Valid {
+		t.Errorf("expected 2000-01-01T00:00:01Z, got %+v", nt)
+	}
+
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, []byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, &nt); !errors.Is(err, nullish.ErrOverflow) {
+		t.Errorf("expected overflow error for infinity, got %v", err)
+	}
+
+	var nu nullish.NullUUID
+	if err := m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, fixtureUUID[:], &nu); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nu.UUID != fixtureUUID || !nu.Valid {
+		t.Errorf("expected UUID=%v Valid=true, got %+v", fixtureUUID, nu)
+	}
+
+	if err := m.Scan(pgtype.TextOID, pgtype.TextFormatCode, []byte(fixtureUUID.String()), &nu); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nu.UUID != fixtureUUID || !nu.Valid {
+		t.Errorf("expected UUID=%v Valid=true, got %+v", fixtureUUID, nu)
+	}
+
+	var nl nullish.NullULID
+	if err := m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, fixtureUUID[:], &nl); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nl.ULID != ulid.ULID(fixtureUUID) || !nl.Valid {
+		t.Errorf("expected ULID=%v Valid=true, got %+v", ulid.ULID(fixtureUUID), nl)
+	}
+
+	var no nullish.NullObj
+	if err := m.Scan(pgtype.JSONBOID, pgtype.BinaryFormatCode, []byte("\x01{\"a\":\"b\"}"), &no); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if no.Obj["a"] != "b" || !no.Valid {
+		t.Errorf("expected a=b Valid=true, got %+v", no)
+	}
+
+	var nao nullish.NullArrObj
+	if err := m.Scan(pgtype.JSONOID, pgtype.TextFormatCode, []byte(`[{"a":"b"}]`), &nao); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(nao.ArrObj) != 1 || nao.ArrObj[0]["a"] != "b" || !nao.Valid {
+		t.Errorf("expected [{a:b}] Valid=true, got %+v", nao)
+	}
+
+	src := []byte(`{"a":1}`)
+	var nj nullish.NullJSON
+	if err := m.Scan(pgtype.JSONOID, pgtype.TextFormatCode, src, &nj); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	src[1] = 'x'
+	if string(nj.Json) != `{"a":1}` || !nj.Valid {
+		t.Errorf("expected detached copy of {\"a\":1}, got %s", nj.Json)
+	}
+}
+
+func TestRegister_TimeNormalization(t *testing.T) {
+	defer func(n nullish.TimeNormalization) { nullish.DefaultTimeNormalization = n }(nullish.DefaultTimeNormalization)
+	nullish.DefaultTimeNormalization = nullish.TimeNormalization{Truncate: time.Second, Location: time.UTC}
+
+	m := newMap()
+
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	nt := nullish.NewNullTime(time.Date(2000, 1, 1, 7, 0, 1, 999, jakarta), true)
+
+	got, err := m.Encode(pgtype.TimestampOID, pgtype.BinaryFormatCode, nt, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x40}; !bytes.Equal(got, want) {
+		t.Errorf("expected %x, got %x", want, got)
+	}
+
+	var scanned nullish.NullTime
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x41}, &scanned); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scanned.Time != time.Date(2000, 1, 1, 0, 0, 1, 0, time.UTC) {
+		t.Errorf("expected truncated UTC time, got %v", scanned.Time)
+	}
+	var unix nullish.NullUnixMilli
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, []byte{0, 0, 0, 0, 0, 0x0f, 0x42, 0x41}, &unix); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unix.Time != time.Date(2000, 1, 1, 0, 0, 1, 0, time.UTC) {
+		t.Errorf("expected truncated UTC time, got %v", unix.Time)
+	}
+}
+
+func TestRegister_UUIDValidator(t *testing.T) {
+	defer func(v func(uuid.UUID) error) { nullish.UUIDValidator = v }(nullish.UUIDValidator)
+	nullish.UUIDValidator = nullish.AllowUUIDVersions(7)
+
+	m := newMap()
+
+	// fixtureUUID is version 1
+	var nu nullish.NullUUID
+	if err := m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, fixtureUUID[:], &nu); !errors.Is(err, nullish.ErrUUIDVersion) {
+		t.Errorf("expected version error, got %v", err)
+	}
+	if err := m.Scan(pgtype.TextOID, pgtype.TextFormatCode, []byte(fixtureUUID.String()), &nu); !errors.Is(err, nullish.ErrUUIDVersion) {
+		t.Errorf("expected version error, got %v", err)
+	}
+
+	v7 := nullish.NewNullUUIDv7()
+	if err := m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, v7.UUID[:], &nu); err != nil || nu != v7 {
+		t.Errorf("expected %v, got %+v err=%v", v7, nu, err)
+	}
+}
+
+func TestRegister_ScanNull(t *testing.T) {
+	m := newMap()
+
+	ns := nullish.NewNullString("hello", true)
+	if err := m.Scan(pgtype.TextOID, pgtype.BinaryFormatCode, nil, &ns); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ns.Valid {
+		t.Error("expected Valid=false for NULL")
+	}
+
+	nu := nullish.NewNullUUID(fixtureUUID, true)
+	if err := m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, nil, &nu); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nu.Valid {
+		t.Error("expected Valid=false for NULL")
+	}
+
+	no := nullish.NewNullObj(map[string]interface{}{"a": "b"}, true)
+	if err := m.Scan(pgtype.JSONBOID, pgtype.BinaryFormatCode, nil, &no); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if no.Valid {
+		t.Error("expected Valid=false for NULL")
+	}
+}
+
+func TestRegister_Idempotent(t *testing.T) {
+	m := newMap()
+	Register(m)
+
+	dt, ok := m.TypeForName("int8")
+	if !ok {
+		t.Fatal("expected int8 to be registered")
+	}
+
+	c, ok := dt.Codec.(*codec)
+	if !ok {
+		t.Fatalf("expected wrapped codec, got %T", dt.Codec)
+	}
+	if _, nested := c.Codec.(*codec); nested {
+		t.Error("expected codec to be wrapped only once")
+	}
+
+	dt, ok = m.TypeForValue(nullish.NullUUID{})
+	if !ok || dt.Name != "uuid" {
+		t.Errorf("expected uuid default type, got %+v", dt)
+	}
+
+	dt, ok = m.TypeForValue(nullish.NullUint64{})
+	if !ok || dt.Name != "numeric" {
+		t.Errorf("expected numeric default type, got %+v", dt)
+	}
+}
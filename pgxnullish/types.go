@@ -0,0 +1,753 @@
+package pgxnullish
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/oklog/ulid/v2"
+	"github.com/sutantodadang/nullish"
+)
+
+// wrapValue converts a nullish value into its pgtype valuer adapter.
+func wrapValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case nullish.NullString:
+		return nullString(v), true
+	case nullish.NullInt:
+		return nullInt(v), true
+	case nullish.NullInt8:
+		return nullInt8(v), true
+	case nullish.NullInt16:
+		return nullInt16(v), true
+	case nullish.NullInt32:
+		return nullInt32(v), true
+	case nullish.NullInt64:
+		return nullInt64(v), true
+	case nullish.NullUint8:
+		return nullUint8(v), true
+	case nullish.NullUint16:
+		return nullUint16(v), true
+	case nullish.NullUint32:
+		return nullUint32(v), true
+	case nullish.NullUint64:
+		return nullUint64(v), true
+	case nullish.NullFloat:
+		return nullFloat(v), true
+	case nullish.NullBool:
+		return nullBool(v), true
+	case nullish.NullTime:
+		return nullTime(v), true
+	case nullish.NullUUID:
+		return nullUUID(v), true
+	case nullish.NullULID:
+		return nullULID(v), true
+	case nullish.NullDecimal:
+		return nullDecimal(v), true
+	case nullish.NullBigInt:
+		return nullBigInt(v), true
+	case nullish.NullDate:
+		return nullDate(v), true
+	case nullish.NullTimeOfDay:
+		return nullTimeOfDay(v), true
+	case nullish.NullDuration:
+		return nullDuration(v), true
+	case nullish.NullInterval:
+		return nullInterval(v), true
+	case nullish.NullUnixTime:
+		return nullTime{Time: v.Time, Valid: v.Valid}, true
+	case nullish.NullUnixMilli:
+		return nullTime{Time: v.Time, Valid: v.Valid}, true
+	case nullish.NullUnixNano:
+		return nullTime{Time: v.Time, Valid: v.Valid}, true
+	}
+
+	return nil, false
+}
+
+// wrapTarget converts a pointer to a nullish value into its pgtype scanner
+// adapter.
+func wrapTarget(target any) (any, bool) {
+	switch t := target.(type) {
+	case *nullish.NullString:
+		return (*nullString)(t), true
+	case *nullish.NullInt:
+		return (*nullInt)(t), true
+	case *nullish.NullInt8:
+		return (*nullInt8)(t), true
+	case *nullish.NullInt16:
+		return (*nullInt16)(t), true
+	case *nullish.NullInt32:
+		return (*nullInt32)(t), true
+	case *nullish.NullInt64:
+		return (*nullInt64)(t), true
+	case *nullish.NullUint8:
+		return (*nullUint8)(t), true
+	case *nullish.NullUint16:
+		return (*nullUint16)(t), true
+	case *nullish.NullUint32:
+		return (*nullUint32)(t), true
+	case *nullish.NullUint64:
+		return (*nullUint64)(t), true
+	case *nullish.NullFloat:
+		return (*nullFloat)(t), true
+	case *nullish.NullBool:
+		return (*nullBool)(t), true
+	case *nullish.NullTime:
+		return (*nullTime)(t), true
+	case *nullish.NullUUID:
+		return (*nullUUID)(t), true
+	case *nullish.NullULID:
+		return (*nullULID)(t), true
+	case *nullish.NullDecimal:
+		return (*nullDecimal)(t), true
+	case *nullish.NullBigInt:
+		return (*nullBigInt)(t), true
+	case *nullish.NullDate:
+		return (*nullDate)(t), true
+	case *nullish.NullTimeOfDay:
+		return (*nullTimeOfDay)(t), true
+	case *nullish.NullDuration:
+		return (*nullDuration)(t), true
+	case *nullish.NullInterval:
+		return (*nullInterval)(t), true
+	case *nullish.NullUnixTime:
+		return &timeTarget{name: "NullUnixTime", time: &t.Time, valid: &t.Valid}, true
+	case *nullish.NullUnixMilli:
+		return &timeTarget{name: "NullUnixMilli", time: &t.Time, valid: &t.Valid}, true
+	case *nullish.NullUnixNano:
+		return &timeTarget{name: "NullUnixNano", time: &t.Time, valid: &t.Valid}, true
+	}
+
+	return nil, false
+}
+
+// jsonValue returns the JSON marshaler of a nullish JSON value and whether
+// it holds a non-null value.
+func jsonValue(value any) (json.Marshaler, bool, bool) {
+	switch v := value.(type) {
+	case nullish.NullJSON:
+		return v, v.Valid, true
+	case nullish.NullObj:
+		return v, v.Valid, true
+	case nullish.NullArr:
+		return v, v.Valid, true
+	case nullish.NullArrObj:
+		return v, v.Valid, true
+	}
+
+	return nil, false, false
+}
+
+// wrapJSONTarget converts a pointer to a nullish JSON value into a
+// pgtype.BytesScanner.
+func wrapJSONTarget(target any) (any, bool) {
+	switch t := target.(type) {
+	case *nullish.NullJSON:
+		return &jsonScanner{target: t, reset: func() { *t = nullish.NullJSON{} }}, true
+	case *nullish.NullObj:
+		return &jsonScanner{target: t, reset: func() { *t = nullish.NullObj{} }}, true
+	case *nullish.NullArr:
+		return &jsonScanner{target: t, reset: func() { *t = nullish.NullArr{} }}, true
+	case *nullish.NullArrObj:
+		return &jsonScanner{target: t, reset: func() { *t = nullish.NullArrObj{} }}, true
+	}
+
+	return nil, false
+}
+
+type nullString nullish.NullString
+
+// ScanText method
+func (n *nullString) ScanText(v pgtype.Text) error {
+	*n = nullString{String: v.String, Valid: v.Valid}
+	return nil
+}
+
+// TextValue method
+func (n nullString) TextValue() (pgtype.Text, error) {
+	return pgtype.Text{String: n.String, Valid: n.Valid}, nil
+}
+
+type nullInt nullish.NullInt
+
+// ScanInt64 method
+func (n *nullInt) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		*n = nullInt{}
+		return nil
+	}
+
+	if v.Int64 < math.MinInt || v.Int64 > math.MaxInt {
+		return nullish.NewScanError("NullInt", v.Int64, nullish.ErrOverflow)
+	}
+
+	*n = nullInt{Int: int(v.Int64), Valid: true}
+
+	return nil
+}
+
+// Int64Value method
+func (n nullInt) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.Int), Valid: n.Valid}, nil
+}
+
+type nullInt8 nullish.NullInt8
+
+// ScanInt64 method
+func (n *nullInt8) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		*n = nullInt8{}
+		return nil
+	}
+
+	if v.Int64 < math.MinInt8 || v.Int64 > math.MaxInt8 {
+		return nullish.NewScanError("NullInt8", v.Int64, nullish.ErrOverflow)
+	}
+
+	*n = nullInt8{Int8: int8(v.Int64), Valid: true}
+
+	return nil
+}
+
+// Int64Value method
+func (n nullInt8) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.Int8), Valid: n.Valid}, nil
+}
+
+type nullInt16 nullish.NullInt16
+
+// ScanInt64 method
+func (n *nullInt16) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		*n = nullInt16{}
+		return nil
+	}
+
+	if v.Int64 < math.MinInt16 || v.Int64 > math.MaxInt16 {
+		return nullish.NewScanError("NullInt16", v.Int64, nullish.ErrOverflow)
+	}
+
+	*n = nullInt16{Int16: int16(v.Int64), Valid: true}
+
+	return nil
+}
+
+// Int64Value method
+func (n nullInt16) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.Int16), Valid: n.Valid}, nil
+}
+
+type nullInt32 nullish.NullInt32
+
+// ScanInt64 method
+func (n *nullInt32) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		*n = nullInt32{}
+		return nil
+	}
+
+	if v.Int64 < math.MinInt32 || v.Int64 > math.MaxInt32 {
+		return nullish.NewScanError("NullInt32", v.Int64, nullish.ErrOverflow)
+	}
+
+	*n = nullInt32{Int32: int32(v.Int64), Valid: true}
+
+	return nil
+}
+
+// Int64Value method
+func (n nullInt32) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.Int32), Valid: n.Valid}, nil
+}
+
+type nullInt64 nullish.NullInt64
+
+// ScanInt64 method
+func (n *nullInt64) ScanInt64(v pgtype.Int8) error {
+	*n = nullInt64{Int64: v.Int64, Valid: v.Valid}
+	return nil
+}
+
+// Int64Value method
+func (n nullInt64) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: n.Int64, Valid: n.Valid}, nil
+}
+
+type nullUint8 nullish.NullUint8
+
+// ScanInt64 method
+func (n *nullUint8) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		*n = nullUint8{}
+		return nil
+	}
+
+	if v.Int64 < 0 || v.Int64 > math.MaxUint8 {
+		return nullish.NewScanError("NullUint8", v.Int64, nullish.ErrOverflow)
+	}
+
+	*n = nullUint8{Uint8: uint8(v.Int64), Valid: true}
+
+	return nil
+}
+
+// Int64Value method
+func (n nullUint8) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.Uint8), Valid: n.Valid}, nil
+}
+
+type nullUint16 nullish.NullUint16
+
+// ScanInt64 method
+func (n *nullUint16) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		*n = nullUint16{}
+		return nil
+	}
+
+	if v.Int64 < 0 || v.Int64 > math.MaxUint16 {
+		return nullish.NewScanError("NullUint16", v.Int64, nullish.ErrOverflow)
+	}
+
+	*n = nullUint16{Uint16: uint16(v.Int64), Valid: true}
+
+	return nil
+}
+
+// Int64Value method
+func (n nullUint16) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.Uint16), Valid: n.Valid}, nil
+}
+
+type nullUint32 nullish.NullUint32
+
+// ScanInt64 method
+func (n *nullUint32) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		*n = nullUint32{}
+		return nil
+	}
+
+	if v.Int64 < 0 || v.Int64 > math.MaxUint32 {
+		return nullish.NewScanError("NullUint32", v.Int64, nullish.ErrOverflow)
+	}
+
+	*n = nullUint32{Uint32: uint32(v.Int64), Valid: true}
+
+	return nil
+}
+
+// Int64Value method
+func (n nullUint32) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.Uint32), Valid: n.Valid}, nil
+}
+
+// nullUint64 is stored as numeric by default, since PostgreSQL has no
+// unsigned 64-bit type, and also scans from and encodes to int8 columns
+// for values up to math.MaxInt64.
+type nullUint64 nullish.NullUint64
+
+// ScanInt64 method
+func (n *nullUint64) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		*n = nullUint64{}
+		return nil
+	}
+
+	if v.Int64 < 0 {
+		return nullish.NewScanError("NullUint64", v.Int64, nullish.ErrOverflow)
+	}
+
+	*n = nullUint64{Uint64: uint64(v.Int64), Valid: true}
+
+	return nil
+}
+
+// Int64Value method
+func (n nullUint64) Int64Value() (pgtype.Int8, error) {
+	if n.Valid && n.Uint64 > math.MaxInt64 {
+		return pgtype.Int8{}, fmt.Errorf("%w: uint64 value %d overflows int8", nullish.ErrOverflow, n.Uint64)
+	}
+
+	return pgtype.Int8{Int64: int64(n.Uint64), Valid: n.Valid}, nil
+}
+
+// ScanNumeric method
+func (n *nullUint64) ScanNumeric(v pgtype.Numeric) error {
+	text, err := v.Value()
+	if err != nil {
+		return err
+	}
+
+	return (*nullish.NullUint64)(n).Scan(text)
+}
+
+// NumericValue method
+func (n nullUint64) NumericValue() (pgtype.Numeric, error) {
+	if !n.Valid {
+		return pgtype.Numeric{}, nil
+	}
+
+	return pgtype.Numeric{Int: new(big.Int).SetUint64(n.Uint64), Valid: true}, nil
+}
+
+type nullFloat nullish.NullFloat
+
+// ScanFloat64 method
+func (n *nullFloat) ScanFloat64(v pgtype.Float8) error {
+	*n = nullFloat{Float: v.Float64, Valid: v.Valid}
+	return nil
+}
+
+// Float64Value method
+func (n nullFloat) Float64Value() (pgtype.Float8, error) {
+	return pgtype.Float8{Float64: n.Float, Valid: n.Valid}, nil
+}
+
+type nullBool nullish.NullBool
+
+// ScanBool method
+func (n *nullBool) ScanBool(v pgtype.Bool) error {
+	*n = nullBool{Bool: v.Bool, Valid: v.Valid}
+	return nil
+}
+
+// BoolValue method
+func (n nullBool) BoolValue() (pgtype.Bool, error) {
+	return pgtype.Bool{Bool: n.Bool, Valid: n.Valid}, nil
+}
+
+type nullTime nullish.NullTime
+
+func (n *nullTime) scanTime(t time.Time, infinity pgtype.InfinityModifier, valid bool) error {
+	if !valid {
+		n.Time, n.Valid = time.Time{}, false
+		return nil
+	}
+
+	if infinity != pgtype.Finite {
+		return nullish.NewScanError("NullTime", infinity, nullish.ErrOverflow)
+	}
+
+	n.Time, n.Valid = nullish.DefaultTimeNormalization.Apply(t), true
+
+	return nil
+}
+
+// ScanTimestamptz method
+func (n *nullTime) ScanTimestamptz(v pgtype.Timestamptz) error {
+	return n.scanTime(v.Time, v.InfinityModifier, v.Valid)
+}
+
+// ScanTimestamp method
+func (n *nullTime) ScanTimestamp(v pgtype.Timestamp) error {
+	return n.scanTime(v.Time, v.InfinityModifier, v.Valid)
+}
+
+// ScanDate method
+func (n *nullTime) ScanDate(v pgtype.Date) error {
+	return n.scanTime(v.Time, v.InfinityModifier, v.Valid)
+}
+
+// TimestamptzValue method
+func (n nullTime) TimestamptzValue() (pgtype.Timestamptz, error) {
+	return pgtype.Timestamptz{Time: nullish.DefaultTimeNormalization.Apply(n.Time), Valid: n.Valid}, nil
+}
+
+// TimestampValue method
+func (n nullTime) TimestampValue() (pgtype.Timestamp, error) {
+	return pgtype.Timestamp{Time: nullish.DefaultTimeNormalization.Apply(n.Time), Valid: n.Valid}, nil
+}
+
+// DateValue method
+func (n nullTime) DateValue() (pgtype.Date, error) {
+	return pgtype.Date{Time: nullish.DefaultTimeNormalization.Apply(n.Time), Valid: n.Valid}, nil
+}
+
+// timeTarget adapts the nullish Unix time types, which hold their value in
+// Time and Valid fields like NullTime, to the pgtype time scanners.
+type timeTarget struct {
+	name  string
+	time  *time.Time
+	valid *bool
+}
+
+func (s *timeTarget) scanTime(t time.Time, infinity pgtype.InfinityModifier, valid bool) error {
+	if !valid {
+		*s.time, *s.valid = time.Time{}, false
+		return nil
+	}
+
+	if infinity != pgtype.Finite {
+		return nullish.NewScanError(s.name, infinity, nullish.ErrOverflow)
+	}
+
+	*s.time, *s.valid = nullish.DefaultTimeNormalization.Apply(t), true
+
+	return nil
+}
+
+// ScanTimestamptz method
+func (s *timeTarget) ScanTimestamptz(v pgtype.Timestamptz) error {
+	return s.scanTime(v.Time, v.InfinityModifier, v.Valid)
+}
+
+// ScanTimestamp method
+func (s *timeTarget) ScanTimestamp(v pgtype.Timestamp) error {
+	return s.scanTime(v.Time, v.InfinityModifier, v.Valid)
+}
+
+// ScanDate method
+func (s *timeTarget) ScanDate(v pgtype.Date) error {
+	return s.scanTime(v.Time, v.InfinityModifier, v.Valid)
+}
+
+type nullDate nullish.NullDate
+
+// ScanDate method
+func (n *nullDate) ScanDate(v pgtype.Date) error {
+	if !v.Valid {
+		n.Date, n.Valid = nullish.Date{}, false
+		return nil
+	}
+
+	if v.InfinityModifier != pgtype.Finite {
+		return nullish.NewScanError("NullDate", v.InfinityModifier, nullish.ErrOverflow)
+	}
+
+	n.Date, n.Valid = nullish.DateOf(v.Time), true
+
+	return nil
+}
+
+// DateValue method
+func (n nullDate) DateValue() (pgtype.Date, error) {
+	if !n.Valid {
+		return pgtype.Date{}, nil
+	}
+
+	return pgtype.Date{Time: n.Date.In(time.UTC), Valid: true}, nil
+}
+
+type nullTimeOfDay nullish.NullTimeOfDay
+
+// ScanTime method
+func (n *nullTimeOfDay) ScanTime(v pgtype.Time) error {
+	if !v.Valid {
+		n.TimeOfDay, n.Valid = nullish.TimeOfDay{}, false
+		return nil
+	}
+
+	return (*nullish.NullTimeOfDay)(n).Scan(time.Duration(v.Microseconds) * time.Microsecond)
+}
+
+// TimeValue method
+func (n nullTimeOfDay) TimeValue() (pgtype.Time, error) {
+	if !n.Valid {
+		return pgtype.Time{}, nil
+	}
+
+	return pgtype.Time{Microseconds: n.TimeOfDay.Duration().Microseconds(), Valid: true}, nil
+}
+
+type nullDuration nullish.NullDuration
+
+// ScanInterval method
+func (n *nullDuration) ScanInterval(v pgtype.Interval) error {
+	if !v.Valid {
+		n.Duration, n.Valid = 0, false
+		return nil
+	}
+
+	if v.Months != 0 {
+		return nullish.NewScanError("NullDuration", fmt.Sprintf("%d mons", v.Months), nullish.ErrOverflow)
+	}
+
+	d := time.Duration(v.Days)*24*time.Hour + time.Duration(v.Microseconds)*time.Microsecond
+	n.Duration, n.Valid = d, true
+
+	return nil
+}
+
+// IntervalValue method
+func (n nullDuration) IntervalValue() (pgtype.Interval, error) {
+	if !n.Valid {
+		return pgtype.Interval{}, nil
+	}
+
+	return pgtype.Interval{Microseconds: n.Duration.Microseconds(), Valid: true}, nil
+}
+
+// ScanInt64 method
+func (n *nullDuration) ScanInt64(v pgtype.Int8) error {
+	n.Duration, n.Valid = time.Duration(v.Int64), v.Valid
+
+	return nil
+}
+
+// Int64Value method
+func (n nullDuration) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.Duration), Valid: n.Valid}, nil
+}
+
+type nullInterval nullish.NullInterval
+
+// ScanInterval method
+func (n *nullInterval) ScanInterval(v pgtype.Interval) error {
+	*n = nullInterval{Months: v.Months, Days: v.Days, Microseconds: v.Microseconds, Valid: v.Valid}
+
+	return nil
+}
+
+// IntervalValue method
+func (n nullInterval) IntervalValue() (pgtype.Interval, error) {
+	return pgtype.Interval{Months: n.Months, Days: n.Days, Microseconds: n.Microseconds, Valid: n.Valid}, nil
+}
+
+type nullUUID nullish.NullUUID
+
+// ScanUUID method
+func (n *nullUUID) ScanUUID(v pgtype.UUID) error {
+	if !v.Valid {
+		*n = nullUUID{}
+		return nil
+	}
+
+	return (*nullish.NullUUID)(n).Scan(v.Bytes[:])
+}
+
+// UUIDValue method
+func (n nullUUID) UUIDValue() (pgtype.UUID, error) {
+	return pgtype.UUID{Bytes: n.UUID, Valid: n.Valid}, nil
+}
+
+// ScanText method
+func (n *nullUUID) ScanText(v pgtype.Text) error {
+	if !v.Valid {
+		*n = nullUUID{}
+		return nil
+	}
+
+	return (*nullish.NullUUID)(n).Scan(v.String)
+}
+
+// TextValue method
+func (n nullUUID) TextValue() (pgtype.Text, error) {
+	if !n.Valid {
+		return pgtype.Text{}, nil
+	}
+
+	return pgtype.Text{String: n.UUID.String(), Valid: true}, nil
+}
+
+type nullULID nullish.NullULID
+
+// ScanUUID method
+func (n *nullULID) ScanUUID(v pgtype.UUID) error {
+	*n = nullULID{ULID: v.Bytes, Valid: v.Valid}
+	return nil
+}
+
+// UUIDValue method
+func (n nullULID) UUIDValue() (pgtype.UUID, error) {
+	return pgtype.UUID{Bytes: n.ULID, Valid: n.Valid}, nil
+}
+
+// ScanText method
+func (n *nullULID) ScanText(v pgtype.Text) error {
+	if !v.Valid {
+		*n = nullULID{}
+		return nil
+	}
+
+	id, err := ulid.ParseStrict(v.String)
+	if err != nil {
+		return nullish.NewScanError("NullULID", v.String, fmt.Errorf("%w: %w", nullish.ErrParse, err))
+	}
+
+	*n = nullULID{ULID: id, Valid: true}
+
+	return nil
+}
+
+// TextValue method
+func (n nullULID) TextValue() (pgtype.Text, error) {
+	if !n.Valid {
+		return pgtype.Text{}, nil
+	}
+
+	return pgtype.Text{String: n.ULID.String(), Valid: true}, nil
+}
+
+type nullDecimal nullish.NullDecimal
+
+// ScanNumeric method
+func (n *nullDecimal) ScanNumeric(v pgtype.Numeric) error {
+	if !v.Valid {
+		n.Decimal, n.Valid = nullish.Decimal{}, false
+		return nil
+	}
+
+	if v.NaN {
+		return nullish.NewScanError("NullDecimal", "NaN", nullish.ErrParse)
+	}
+
+	if v.InfinityModifier != pgtype.Finite {
+		return nullish.NewScanError("NullDecimal", v.InfinityModifier, nullish.ErrOverflow)
+	}
+
+	n.Decimal, n.Valid = nullish.NewDecimalFromBigInt(v.Int, -v.Exp), true
+
+	return nil
+}
+
+// NumericValue method
+func (n nullDecimal) NumericValue() (pgtype.Numeric, error) {
+	if !n.Valid {
+		return pgtype.Numeric{}, nil
+	}
+
+	return pgtype.Numeric{Int: n.Decimal.Unscaled(), Exp: -n.Decimal.Scale(), Valid: true}, nil
+}
+
+type nullBigInt nullish.NullBigInt
+
+// ScanNumeric method
+func (n *nullBigInt) ScanNumeric(v pgtype.Numeric) error {
+	text, err := v.Value()
+	if err != nil {
+		return err
+	}
+
+	return (*nullish.NullBigInt)(n).Scan(text)
+}
+
+// NumericValue method
+func (n nullBigInt) NumericValue() (pgtype.Numeric, error) {
+	if !n.Valid || n.BigInt == nil {
+		return pgtype.Numeric{}, nil
+	}
+
+	return pgtype.Numeric{Int: new(big.Int).Set(n.BigInt), Valid: true}, nil
+}
+
+// jsonScanner decodes json and jsonb values into a nullish JSON type.
+type jsonScanner struct {
+	target json.Unmarshaler
+	reset  func()
+}
+
+// ScanBytes method
+func (s *jsonScanner) ScanBytes(v []byte) error {
+	if v == nil {
+		s.reset()
+		return nil
+	}
+
+	// v is driver memory that is only valid until the next database call.
+	return s.target.UnmarshalJSON(append([]byte(nil), v...))
+}
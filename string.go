@@ -2,12 +2,18 @@ package nullish
 
 import (
 	"bytes"
+	"database/sql"
 	"database/sql/driver"
-	"errors"
-
-	"github.com/goccy/go-json"
+	"strconv"
+	"time"
 )
 
+// NullString is a nullable string.
+//
+// Scan is lenient so it works across drivers: []byte and sql.RawBytes are
+// copied, integers are formatted in base 10, floats in the shortest
+// representation that round-trips ('g' format), booleans as "true" or
+// "false" and time.Time as RFC 3339 with nanoseconds.
 type NullString struct {
 	String string
 	Valid  bool
@@ -15,12 +21,7 @@ type NullString struct {
 
 // Value method
 func (ns NullString) Value() (driver.Value, error) {
-
-	if !ns.Valid {
-		return nil, nil
-	}
-
-	return ns.String, nil
+	return NewNull(ns.String, ns.Valid).Value()
 }
 
 // Scan method
@@ -31,41 +32,103 @@ func (ns *NullString) Scan(value interface{}) error {
 		return nil
 	}
 
-	b, ok := value.(string)
-	if !ok {
-		return errors.New("type assertion to string is failed")
-	}
+	switch t := value.(type) {
+	case string:
+		ns.String, ns.Valid = t, true
+
+	// string conversion copies the bytes, database/sql may reuse the buffer.
+	case []byte:
+		ns.String, ns.Valid = string(t), true
+
+	case sql.RawBytes:
+		ns.String, ns.Valid = string(t), true
+
+	case int:
+		ns.String, ns.Valid = strconv.FormatInt(int64(t), 10), true
+
+	case int8:
+		ns.String, ns.Valid = strconv.FormatInt(int64(t), 10), true
+
+	case int16:
+		ns.String, ns.Valid = strconv.FormatInt(int64(t), 10), true
 
-	ns.String, ns.Valid = b, true
+	case int32:
+		ns.String, ns.Valid = strconv.FormatInt(int64(t), 10), true
+
+	case int64:
+		ns.String, ns.Valid = strconv.FormatInt(t, 10), true
+
+	case uint:
+		ns.String, ns.Valid = strconv.FormatUint(uint64(t), 10), true
+
+	case uint8:
+		ns.String, ns.Valid = strconv.FormatUint(uint64(t), 10), true
+
+	case uint16:
+		ns.String, ns.Valid = strconv.FormatUint(uint64(t), 10), true
+
+	case uint32:
+		ns.String, ns.Valid = strconv.FormatUint(uint64(t), 10), true
+
+	case uint64:
+		ns.String, ns.Valid = strconv.FormatUint(t, 10), true
+
+	case float32:
+		ns.String, ns.Valid = strconv.FormatFloat(float64(t), 'g', -1, 32), true
+
+	case float64:
+		ns.String, ns.Valid = strconv.FormatFloat(t, 'g', -1, 64), true
+
+	case bool:
+		ns.String, ns.Valid = strconv.FormatBool(t), true
+
+	case time.Time:
+		ns.String, ns.Valid = t.Format(time.RFC3339Nano), true
+
+	default:
+		return unsupportedSourceError("NullString", value)
+	}
 
 	return nil
 }
 
 // MarshalJSON method
 func (ns NullString) MarshalJSON() ([]byte, error) {
+	return NewNull(ns.String, ns.Valid).MarshalJSON()
+}
 
-	if !ns.Valid {
-		return NullType, nil
+// UnmarshalJSON method
+func (ns *NullString) UnmarshalJSON(data []byte) error {
+	var res Null[string]
+
+	err := res.UnmarshalJSON(data)
+	if err != nil {
+		return err
 	}
 
-	return json.Marshal(ns.String)
+	*ns = NullString{String: res.V, Valid: res.Valid}
+
+	return nil
 }
 
-// UnmarshalJSON method
-func (ns *NullString) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, NullType) {
-		*ns = NullString{}
-		return nil
+// MarshalText method
+func (ns NullString) MarshalText() ([]byte, error) {
+
+	if !ns.Valid {
+		return NullText, nil
 	}
 
-	var res string
+	return []byte(ns.String), nil
+}
 
-	err := json.Unmarshal(data, &res)
-	if err != nil {
-		return err
+// UnmarshalText method
+func (ns *NullString) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*ns = NullString{}
+		return nil
 	}
 
-	*ns = NullString{String: res, Valid: true}
+	*ns = NullString{String: string(text), Valid: true}
 
 	return nil
 }
@@ -1,7 +1,10 @@
 package nullish
 
 import (
+	"database/sql"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/goccy/go-json"
 )
@@ -63,6 +66,59 @@ func TestNullString_JSON(t *testing.T) {
 	}
 }
 
+func TestNullString_ScanLenient(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"bytes", []byte("mysql"), "mysql"},
+		{"raw bytes", sql.RawBytes("raw"), "raw"},
+		{"int64", int64(-42), "-42"},
+		{"int", 7, "7"},
+		{"uint64", uint64(18446744073709551615), "18446744073709551615"},
+		{"float64", 1.5, "1.5"},
+		{"float32", float32(0.1), "0.1"},
+		{"bool", true, "true"},
+		{"time", ts, "2024-01-02T15:04:05Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ns NullString
+			err := ns.Scan(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ns.String != tt.want || !ns.Valid {
+				t.Errorf("expected String=%q Valid=true, got String=%q Valid=%v", tt.want, ns.String, ns.Valid)
+			}
+		})
+	}
+}
+
+func TestNullString_ScanCopiesBuffer(t *testing.T) {
+	buf := []byte("first")
+
+	var ns NullString
+	err := ns.Scan(buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	copy(buf, "xxxxx")
+	if ns.String != "first" {
+		t.Errorf("expected String=first after buffer reuse, got %q", ns.String)
+	}
+
+	err = ns.Scan([]int{1})
+	if !errors.Is(err, ErrUnsupportedSource) {
+		t.Errorf("expected unsupported source error, got %v", err)
+	}
+}
+
 func BenchmarkNullString_Value(b *testing.B) {
 	ns := NewNullString("benchmark", true)
 	for i := 0; i < b.N; i++ {
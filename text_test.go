@@ -0,0 +1,163 @@
+package nullish
+
+import (
+	"encoding"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+type textValue interface {
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}
+
+func TestText_RoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 30, 0, 123456789, time.UTC)
+	id := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	lid := ulid.MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	tests := []struct {
+		name    string
+		value   textValue
+		decoded textValue
+		text    string
+	}{
+		{"NullString", &NullString{String: "hello", Valid: true}, &NullString{}, "hello"},
+		{"NullInt", &NullInt{Int: -42, Valid: true}, &NullInt{}, "-42"},
+		{"NullFloat", &NullFloat{Float: 1.25, Valid: true}, &NullFloat{}, "1.25"},
+		{"NullBool", &NullBool{Bool: true, Valid: true}, &NullBool{}, "true"},
+		{"NullTime", &NullTime{Time: now, Valid: true}, &NullTime{}, "2024-05-01T10:30:00.123456789Z"},
+		{"NullUUID", &NullUUID{UUID: id, Valid: true}, &NullUUID{}, id.String()},
+		{"NullULID", &NullULID{ULID: lid, Valid: true}, &NullULID{}, lid.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.value.MarshalText()
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+			if string(text) != tt.text {
+				t.Errorf("expected %s, got %s", tt.text, text)
+			}
+
+			err = tt.decoded.UnmarshalText(text)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			again, err := tt.decoded.MarshalText()
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+			if string(again) != tt.text {
+				t.Errorf("roundtrip failed: expected %s, got %s", tt.text, again)
+			}
+
+			// The empty string is null by default
+			err = tt.decoded.UnmarshalText([]byte(""))
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			text, err = tt.decoded.MarshalText()
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+			if len(text) != 0 {
+				t.Errorf("expected empty text for null, got %s", text)
+			}
+		})
+	}
+}
+
+func TestText_Invalid(t *testing.T) {
+	var ni NullInt
+	if err := ni.UnmarshalText([]byte("abc")); err == nil {
+		t.Error("expected error for invalid int")
+	}
+
+	var nb NullBool
+	if err := nb.UnmarshalText([]byte("maybe")); err == nil {
+		t.Error("expected error for invalid bool")
+	}
+
+	var nu NullUUID
+	if err := nu.UnmarshalText([]byte("not-a-uuid")); err == nil {
+		t.Error("expected error for invalid uuid")
+	}
+}
+
+func TestText_NullToken(t *testing.T) {
+	defer func(token []byte) { NullText = token }(NullText)
+	NullText = []byte("NULL")
+
+	ns := NewNullString("", false)
+	text, err := ns.MarshalText()
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(text) != "NULL" {
+		t.Errorf("expected NULL, got %s", text)
+	}
+
+	// The empty string is a valid value once the token changes
+	err = ns.UnmarshalText([]byte(""))
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if !ns.Valid {
+		t.Error("expected Valid=true for empty string")
+	}
+
+	err = ns.UnmarshalText([]byte("NULL"))
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if ns.Valid {
+		t.Error("expected Valid=false for NULL token")
+	}
+}
+
+func TestText_MapKey(t *testing.T) {
+	m := map[NullInt]string{
+		NewNullInt(1, true): "one",
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `{"1":"one"}` {
+		t.Errorf("expected {\"1\":\"one\"}, got %s", data)
+	}
+
+	var decoded map[NullInt]string
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if decoded[NewNullInt(1, true)] != "one" {
+		t.Errorf("roundtrip failed: got %v", decoded)
+	}
+}
+
+func TestText_FlagTextVar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	var limit NullInt
+	fs.TextVar(&limit, "limit", NullInt{}, "result limit")
+
+	err := fs.Parse([]string{"-limit", "25"})
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if limit.Int != 25 || !limit.Valid {
+		t.Errorf("expected Int=25 Valid=true, got %+v", limit)
+	}
+}
@@ -1,14 +1,78 @@
 package nullish
 
 import (
-	"bytes"
 	"database/sql/driver"
-	"errors"
 	"time"
-
-	"github.com/goccy/go-json"
 )
 
+// TimeLayouts lists the layouts NullTime.Scan tries, in order, when the
+// driver returns a timestamp as string or []byte, as SQLite does for TEXT
+// columns and MySQL does without parseTime=true.
+var TimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	time.DateOnly,
+}
+
+// TimeEpochUnit is the unit of integer epoch values scanned by NullTime,
+// e.g. time.Second for SQLite unixepoch() or time.Millisecond.
+var TimeEpochUnit = time.Second
+
+// TimeLocation is the location NullTime.Scan assigns to epoch values and to
+// textual timestamps without a zone offset. NullTimeIn.Location overrides it.
+var TimeLocation = time.UTC
+
+// TimeNormalization adjusts times so they survive a database round-trip
+// unchanged, e.g. PostgreSQL keeps microseconds and MySQL DATETIME drops
+// the zone.
+type TimeNormalization struct {
+	// Truncate drops precision below this unit, e.g. time.Microsecond.
+	// Zero keeps nanoseconds.
+	Truncate time.Duration
+
+	// Location converts times to this location, e.g. time.UTC. Nil keeps
+	// the location of each time.
+	Location *time.Location
+
+	// StripMonotonic drops the monotonic clock reading of time.Now values.
+	StripMonotonic bool
+}
+
+// Apply returns t normalized by n.
+func (n TimeNormalization) Apply(t time.Time) time.Time {
+	if n.Truncate > 0 {
+		t = t.Truncate(n.Truncate)
+	}
+
+	if n.Location != nil {
+		t = t.In(n.Location)
+	}
+
+	if n.StripMonotonic {
+		t = t.Round(0)
+	}
+
+	return t
+}
+
+// DefaultTimeNormalization is applied by NullTime.Value, NullTime.Scan and
+// NullTime.Equal. The default leaves times unchanged. For PostgreSQL:
+//
+//	nullish.DefaultTimeNormalization = nullish.TimeNormalization{
+//		Truncate:       time.Microsecond,
+//		Location:       time.UTC,
+//		StripMonotonic: true,
+//	}
+var DefaultTimeNormalization TimeNormalization
+
+// NullTime is a nullable time.Time.
+//
+// Besides time.Time, Scan accepts string and []byte parsed with TimeLayouts,
+// and integer epoch values in TimeEpochUnit. JSON and text follow
+// DefaultTimeFormat. Value and Scan apply DefaultTimeNormalization.
 type NullTime struct {
 	Time  time.Time
 	Valid bool
@@ -21,57 +85,121 @@ func (nt NullTime) Value() (driver.Value, error) {
 		return nil, nil
 	}
 
-	return nt.Time, nil
+	return DefaultTimeNormalization.Apply(nt.Time), nil
 }
 
 // Scan method
 func (nt *NullTime) Scan(value interface{}) error {
+	return nt.scan(value, TimeLocation)
+}
+
+// scan is Scan reading epoch values and zone-less text in loc.
+func (nt *NullTime) scan(value interface{}, loc *time.Location) error {
 
 	if value == nil {
 		nt.Time, nt.Valid = time.Time{}, false
 		return nil
 	}
 
-	b, ok := value.(time.Time)
-	if !ok {
-		return errors.New("type assertion to time is failed")
+	switch t := value.(type) {
+	case time.Time:
+		nt.Time, nt.Valid = t, true
+
+	case string:
+		if err := nt.parse(value, t, loc); err != nil {
+			return err
+		}
+
+	case []byte:
+		if err := nt.parse(value, string(t), loc); err != nil {
+			return err
+		}
+
+	case int64:
+		nt.Time, nt.Valid = epochTime(t, TimeEpochUnit).In(loc), true
+
+	case int:
+		nt.Time, nt.Valid = epochTime(int64(t), TimeEpochUnit).In(loc), true
+
+	case int32:
+		nt.Time, nt.Valid = epochTime(int64(t), TimeEpochUnit).In(loc), true
+
+	default:
+		return unsupportedSourceError("NullTime", value)
 	}
 
-	nt.Time, nt.Valid = b, true
+	nt.Time = DefaultTimeNormalization.Apply(nt.Time)
 
 	return nil
 }
 
 // MarshalJSON method
 func (nt NullTime) MarshalJSON() ([]byte, error) {
-
-	if !nt.Valid {
-		return NullType, nil
-	}
-
-	return json.Marshal(nt.Time.Format(time.RFC3339Nano))
+	return DefaultTimeFormat.marshalJSON(nt)
 }
 
 // UnmarshalJSON method
 func (nt *NullTime) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, NullType) {
-		*nt = NullTime{}
-		return nil
+	return DefaultTimeFormat.unmarshalJSON(nt, data, TimeLocation)
+}
+
+// MarshalText method
+func (nt NullTime) MarshalText() ([]byte, error) {
+	return DefaultTimeFormat.marshalText(nt)
+}
+
+// UnmarshalText method
+func (nt *NullTime) UnmarshalText(text []byte) error {
+	return DefaultTimeFormat.unmarshalText(nt, text, TimeLocation)
+}
+
+// Equal reports whether nt and other are both null, or both valid and
+// equal once normalized with DefaultTimeNormalization.
+func (nt NullTime) Equal(other NullTime) bool {
+	if !nt.Valid || !other.Valid {
+		return nt.Valid == other.Valid
 	}
 
-	var res string
+	return DefaultTimeNormalization.Apply(nt.Time).Equal(DefaultTimeNormalization.Apply(other.Time))
+}
+
+func (nt *NullTime) parse(value interface{}, s string, loc *time.Location) error {
+	var firstErr error
+
+	for _, layout := range TimeLayouts {
+		res, err := time.ParseInLocation(layout, s, loc)
+		if err == nil {
+			nt.Time, nt.Valid = res, true
+			return nil
+		}
 
-	err := json.Unmarshal(data, &res)
-	if err != nil {
-		return err
+		if firstErr == nil {
+			firstErr = err
+		}
 	}
 
-	pTime, err := time.Parse(time.RFC3339Nano, res)
-	if err != nil {
-		return err
+	if firstErr == nil {
+		return unsupportedSourceError("NullTime", value)
 	}
 
-	*nt = NullTime{Time: pTime, Valid: true}
+	return parseError("NullTime", value, firstErr)
+}
 
-	return nil
+// epochTime converts v, counted in unit since the Unix epoch, to a time.Time.
+func epochTime(v int64, unit time.Duration) time.Time {
+	switch unit {
+	case time.Second:
+		return time.Unix(v, 0)
+	case time.Millisecond:
+		return time.UnixMilli(v)
+	case time.Microsecond:
+		return time.UnixMicro(v)
+	}
+
+	perSecond := int64(time.Second / unit)
+	if perSecond == 0 {
+		return time.Unix(v*int64(unit/time.Second), 0)
+	}
+
+	return time.Unix(v/perSecond, (v%perSecond)*int64(unit))
 }
@@ -0,0 +1,205 @@
+package nullish
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// TimeFormat is a policy for encoding NullTime in JSON and text.
+type TimeFormat struct {
+	// Layouts are tried in order when decoding. Empty means Output only.
+	// Layouts without a zone are read in TimeLocation, or in the Location
+	// of a NullTimeIn.
+	Layouts []string
+
+	// Output is the layout used when encoding. Empty means RFC3339Nano.
+	Output string
+
+	// UTC converts times to UTC when encoding and decoding.
+	UTC bool
+
+	// EpochUnit, when set, also accepts JSON numbers and numeric strings
+	// as counts of this unit since the Unix epoch, as some mobile clients
+	// send.
+	EpochUnit time.Duration
+}
+
+// DefaultTimeFormat is the TimeFormat NullTime uses for JSON and text. The
+// default reads and writes RFC3339Nano only. To accept every form Scan
+// accepts plus epoch seconds:
+//
+//	nullish.DefaultTimeFormat = nullish.TimeFormat{
+//		Layouts:   nullish.TimeLayouts,
+//		EpochUnit: time.Second,
+//	}
+var DefaultTimeFormat = TimeFormat{
+	Layouts: []string{time.RFC3339Nano},
+	Output:  time.RFC3339Nano,
+}
+
+// TimeFormatter supplies the TimeFormat of a NullTimeAs. Implement it on an
+// empty struct type:
+//
+//	type APITime struct{}
+//
+//	func (APITime) TimeFormat() nullish.TimeFormat {
+//		return nullish.TimeFormat{Layouts: []string{time.DateTime}, Output: time.DateTime, UTC: true}
+//	}
+type TimeFormatter interface {
+	TimeFormat() TimeFormat
+}
+
+// NullTimeAs is a NullTime whose JSON and text encodings follow the
+// TimeFormat of F instead of DefaultTimeFormat. Value and Scan are the ones
+// of NullTime.
+type NullTimeAs[F TimeFormatter] struct {
+	NullTime
+}
+
+// MarshalJSON method
+func (nt NullTimeAs[F]) MarshalJSON() ([]byte, error) {
+	var f F
+
+	return f.TimeFormat().marshalJSON(nt.NullTime)
+}
+
+// UnmarshalJSON method
+func (nt *NullTimeAs[F]) UnmarshalJSON(data []byte) error {
+	var f F
+
+	return f.TimeFormat().unmarshalJSON(&nt.NullTime, data, TimeLocation)
+}
+
+// MarshalText method
+func (nt NullTimeAs[F]) MarshalText() ([]byte, error) {
+	var f F
+
+	return f.TimeFormat().marshalText(nt.NullTime)
+}
+
+// UnmarshalText method
+func (nt *NullTimeAs[F]) UnmarshalText(text []byte) error {
+	var f F
+
+	return f.TimeFormat().unmarshalText(&nt.NullTime, text, TimeLocation)
+}
+
+func (f TimeFormat) format(t time.Time) string {
+	if f.UTC {
+		t = t.UTC()
+	}
+
+	if f.Output == "" {
+		return t.Format(time.RFC3339Nano)
+	}
+
+	return t.Format(f.Output)
+}
+
+func (f TimeFormat) parse(s string, loc *time.Location) (time.Time, error) {
+	layouts := f.Layouts
+	if len(layouts) == 0 {
+		layouts = []string{f.Output}
+		if f.Output == "" {
+			layouts[0] = time.RFC3339Nano
+		}
+	}
+
+	var firstErr error
+
+	for _, layout := range layouts {
+		res, err := time.ParseInLocation(layout, s, loc)
+		if err == nil {
+			return f.normalize(res), nil
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if f.EpochUnit != 0 {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return f.normalize(epochTime(i, f.EpochUnit).In(loc)), nil
+		}
+	}
+
+	return time.Time{}, firstErr
+}
+
+func (f TimeFormat) normalize(t time.Time) time.Time {
+	if f.UTC {
+		return t.UTC()
+	}
+
+	return t
+}
+
+func (f TimeFormat) marshalJSON(nt NullTime) ([]byte, error) {
+	if !nt.Valid {
+		return NullType, nil
+	}
+
+	return json.Marshal(f.format(nt.Time))
+}
+
+func (f TimeFormat) unmarshalJSON(nt *NullTime, data []byte, loc *time.Location) error {
+	if bytes.Equal(data, NullType) {
+		*nt = NullTime{}
+		return nil
+	}
+
+	if f.EpochUnit != 0 && len(data) > 0 && data[0] != '"' {
+		i, err := unmarshalInt(data, 64)
+		if err != nil {
+			return err
+		}
+
+		*nt = NullTime{Time: f.normalize(epochTime(i, f.EpochUnit).In(loc)), Valid: true}
+
+		return nil
+	}
+
+	var res string
+
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		return err
+	}
+
+	pTime, err := f.parse(res, loc)
+	if err != nil {
+		return err
+	}
+
+	*nt = NullTime{Time: pTime, Valid: true}
+
+	return nil
+}
+
+func (f TimeFormat) marshalText(nt NullTime) ([]byte, error) {
+	if !nt.Valid {
+		return NullText, nil
+	}
+
+	return []byte(f.format(nt.Time)), nil
+}
+
+func (f TimeFormat) unmarshalText(nt *NullTime, text []byte, loc *time.Location) error {
+	if bytes.Equal(text, NullText) {
+		*nt = NullTime{}
+		return nil
+	}
+
+	res, err := f.parse(string(text), loc)
+	if err != nil {
+		return err
+	}
+
+	*nt = NullTime{Time: res, Valid: true}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package nullish
+
+import "time"
+
+// NullTimeIn is a NullTime that reads epoch values and timestamps without a
+// zone offset in Location instead of TimeLocation, for the odd column or
+// payload stored in another zone. Value and the marshal methods are the
+// ones of NullTime.
+//
+//	created := nullish.NullTimeIn{Location: jakarta}
+//	err := row.Scan(&created) // "2024-01-02 15:04:05" is read as WIB
+type NullTimeIn struct {
+	NullTime
+
+	// Location is kept across Scan and Unmarshal. Nil means TimeLocation.
+	Location *time.Location
+}
+
+// Scan method
+func (nt *NullTimeIn) Scan(value interface{}) error {
+	return nt.NullTime.scan(value, nt.location())
+}
+
+// UnmarshalJSON method
+func (nt *NullTimeIn) UnmarshalJSON(data []byte) error {
+	return DefaultTimeFormat.unmarshalJSON(&nt.NullTime, data, nt.location())
+}
+
+// UnmarshalText method
+func (nt *NullTimeIn) UnmarshalText(text []byte) error {
+	return DefaultTimeFormat.unmarshalText(&nt.NullTime, text, nt.location())
+}
+
+func (nt *NullTimeIn) location() *time.Location {
+	if nt.Location != nil {
+		return nt.Location
+	}
+
+	return TimeLocation
+}
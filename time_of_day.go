@@ -0,0 +1,496 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// TimeOfDay is a wall clock time with microsecond precision, matching TIME
+// columns. Hour 24 is only valid as 24:00:00, which PostgreSQL accepts as
+// the end of a day.
+type TimeOfDay struct {
+	Hour        int
+	Minute      int
+	Second      int
+	Microsecond int
+}
+
+// TimeOfDayOf returns the wall clock time of t in its own location,
+// truncated to microseconds.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Microsecond: t.Nanosecond() / 1000}
+}
+
+// TimeOfDayFromDuration returns the time of day d after midnight, truncated
+// to microseconds. d must be between 0 and 24h.
+func TimeOfDayFromDuration(d time.Duration) (TimeOfDay, error) {
+	if d < 0 || d > 24*time.Hour {
+		return TimeOfDay{}, ErrOverflow
+	}
+
+	return TimeOfDay{
+		Hour:        int(d / time.Hour),
+		Minute:      int(d % time.Hour / time.Minute),
+		Second:      int(d % time.Minute / time.Second),
+		Microsecond: int(d % time.Second / time.Microsecond),
+	}, nil
+}
+
+// ParseTimeOfDay parses "15:04", "15:04:05" or "15:04:05.999999". Digits
+// beyond microseconds are truncated.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return TimeOfDay{}, fmt.Errorf("invalid time of day %q", s)
+	}
+
+	var (
+		tod  TimeOfDay
+		frac string
+		err  error
+	)
+
+	tod.Hour, err = parseClockField(parts[0])
+	if err == nil {
+		tod.Minute, err = parseClockField(parts[1])
+	}
+	if err == nil && len(parts) == 3 {
+		var sec string
+		sec, frac, _ = strings.Cut(parts[2], ".")
+		tod.Second, err = parseClockField(sec)
+	}
+	if err == nil && frac != "" {
+		tod.Microsecond, err = parseMicroseconds(frac)
+	}
+
+	if err != nil || !tod.valid() {
+		return TimeOfDay{}, fmt.Errorf("invalid time of day %q", s)
+	}
+
+	return tod, nil
+}
+
+func parseClockField(s string) (int, error) {
+	if len(s) == 0 || len(s) > 2 {
+		return 0, strconv.ErrSyntax
+	}
+
+	return strconv.Atoi(s)
+}
+
+// parseMicroseconds parses the digits after a decimal point as
+// microseconds, truncating digits beyond the sixth.
+func parseMicroseconds(frac string) (int, error) {
+	if strings.TrimLeft(frac, "0123456789") != "" {
+		return 0, strconv.ErrSyntax
+	}
+
+	if len(frac) > 6 {
+		frac = frac[:6]
+	}
+
+	return strconv.Atoi(frac + strings.Repeat("0", 6-len(frac)))
+}
+
+func (t TimeOfDay) valid() bool {
+	if t.Hour == 24 {
+		return t.Minute == 0 && t.Second == 0 && t.Microsecond == 0
+	}
+
+	return t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 &&
+		t.Microsecond >= 0 && t.Microsecond < 1000000
+}
+
+// String returns t as "15:04:05" followed by the fraction of a second,
+// without trailing zeros, when it is not zero.
+func (t TimeOfDay) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+
+	if t.Microsecond != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%06d", t.Microsecond), "0")
+	}
+
+	return s
+}
+
+// Duration returns the time elapsed since midnight.
+func (t TimeOfDay) Duration() time.Duration {
+	return time.Duration(t.Hour)*time.Hour +
+		time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second +
+		time.Duration(t.Microsecond)*time.Microsecond
+}
+
+// On returns the time t on date d in loc.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, t.Hour, t.Minute, t.Second, t.Microsecond*1000, loc)
+}
+
+// Compare returns -1, 0 or +1 depending on whether t is before, equal to or
+// after other.
+func (t TimeOfDay) Compare(other TimeOfDay) int {
+	switch a, b := t.Duration(), other.Duration(); {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+// Before reports whether t is before other.
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t.Compare(other) < 0
+}
+
+// After reports whether t is after other.
+func (t TimeOfDay) After(other TimeOfDay) bool {
+	return t.Compare(other) > 0
+}
+
+// NullTimeOfDay is a nullable TimeOfDay for TIME columns.
+//
+// Scan accepts time.Time, time.Duration since midnight, and string or
+// []byte in the forms of ParseTimeOfDay. Value and JSON use
+// "15:04:05.999999".
+type NullTimeOfDay struct {
+	TimeOfDay TimeOfDay
+	Valid     bool
+}
+
+// Value method
+func (nt NullTimeOfDay) Value() (driver.Value, error) {
+
+	if !nt.Valid {
+		return nil, nil
+	}
+
+	return nt.TimeOfDay.String(), nil
+}
+
+// Scan method
+func (nt *NullTimeOfDay) Scan(value interface{}) error {
+
+	if value == nil {
+		nt.TimeOfDay, nt.Valid = TimeOfDay{}, false
+		return nil
+	}
+
+	res, err := scanTimeOfDay("NullTimeOfDay", value)
+	if err != nil {
+		return err
+	}
+
+	nt.TimeOfDay, nt.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nt NullTimeOfDay) MarshalJSON() ([]byte, error) {
+
+	if !nt.Valid {
+		return NullType, nil
+	}
+
+	return json.Marshal(nt.TimeOfDay.String())
+}
+
+// UnmarshalJSON method
+func (nt *NullTimeOfDay) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nt = NullTimeOfDay{}
+		return nil
+	}
+
+	var res string
+
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		return err
+	}
+
+	tod, err := ParseTimeOfDay(res)
+	if err != nil {
+		return err
+	}
+
+	*nt = NullTimeOfDay{TimeOfDay: tod, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nt NullTimeOfDay) MarshalText() ([]byte, error) {
+
+	if !nt.Valid {
+		return NullText, nil
+	}
+
+	return []byte(nt.TimeOfDay.String()), nil
+}
+
+// UnmarshalText method
+func (nt *NullTimeOfDay) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nt = NullTimeOfDay{}
+		return nil
+	}
+
+	res, err := ParseTimeOfDay(string(text))
+	if err != nil {
+		return err
+	}
+
+	*nt = NullTimeOfDay{TimeOfDay: res, Valid: true}
+
+	return nil
+}
+
+// On combines nt with date into a NullTime in loc. The result is null if
+// either value is null.
+func (nt NullTimeOfDay) On(date NullDate, loc *time.Location) NullTime {
+	if !nt.Valid || !date.Valid {
+		return NullTime{}
+	}
+
+	return NullTime{Time: nt.TimeOfDay.On(date.Date, loc), Valid: true}
+}
+
+// NullTimeOfDayTZ is a nullable TimeOfDay with a UTC offset, for TIMETZ
+// columns.
+//
+// Scan accepts time.Time, taking the offset of its location, and string or
+// []byte such as "15:04:05.999999+07" or "15:04:05+05:30". Value and JSON
+// use "15:04:05.999999+07:00".
+type NullTimeOfDayTZ struct {
+	TimeOfDay TimeOfDay
+
+	// Offset is the UTC offset in seconds east of UTC.
+	Offset int
+
+	Valid bool
+}
+
+// Value method
+func (nt NullTimeOfDayTZ) Value() (driver.Value, error) {
+
+	if !nt.Valid {
+		return nil, nil
+	}
+
+	return nt.String(), nil
+}
+
+// Scan method
+func (nt *NullTimeOfDayTZ) Scan(value interface{}) error {
+
+	if value == nil {
+		nt.TimeOfDay, nt.Offset, nt.Valid = TimeOfDay{}, 0, false
+		return nil
+	}
+
+	var str string
+
+	switch t := value.(type) {
+	case time.Time:
+		_, offset := t.Zone()
+		nt.TimeOfDay, nt.Offset, nt.Valid = TimeOfDayOf(t), offset, true
+		return nil
+
+	case string:
+		str = t
+
+	case []byte:
+		str = string(t)
+
+	default:
+		return unsupportedSourceError("NullTimeOfDayTZ", value)
+	}
+
+	tod, offset, err := parseTimeOfDayTZ(str)
+	if err != nil {
+		return parseError("NullTimeOfDayTZ", value, err)
+	}
+
+	nt.TimeOfDay, nt.Offset, nt.Valid = tod, offset, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nt NullTimeOfDayTZ) MarshalJSON() ([]byte, error) {
+
+	if !nt.Valid {
+		return NullType, nil
+	}
+
+	return json.Marshal(nt.String())
+}
+
+// UnmarshalJSON method
+func (nt *NullTimeOfDayTZ) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nt = NullTimeOfDayTZ{}
+		return nil
+	}
+
+	var res string
+
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		return err
+	}
+
+	tod, offset, err := parseTimeOfDayTZ(res)
+	if err != nil {
+		return err
+	}
+
+	*nt = NullTimeOfDayTZ{TimeOfDay: tod, Offset: offset, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nt NullTimeOfDayTZ) MarshalText() ([]byte, error) {
+
+	if !nt.Valid {
+		return NullText, nil
+	}
+
+	return []byte(nt.String()), nil
+}
+
+// UnmarshalText method
+func (nt *NullTimeOfDayTZ) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nt = NullTimeOfDayTZ{}
+		return nil
+	}
+
+	tod, offset, err := parseTimeOfDayTZ(string(text))
+	if err != nil {
+		return err
+	}
+
+	*nt = NullTimeOfDayTZ{TimeOfDay: tod, Offset: offset, Valid: true}
+
+	return nil
+}
+
+// String returns nt as "15:04:05.999999+07:00", or "" if it is null.
+func (nt NullTimeOfDayTZ) String() string {
+	if !nt.Valid {
+		return ""
+	}
+
+	sign, offset := '+', nt.Offset
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+
+	s := fmt.Sprintf("%s%c%02d:%02d", nt.TimeOfDay, sign, offset/3600, offset%3600/60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf(":%02d", offset%60)
+	}
+
+	return s
+}
+
+// On combines nt with date into a NullTime in a fixed zone with the offset
+// of nt. The result is null if either value is null.
+func (nt NullTimeOfDayTZ) On(date NullDate) NullTime {
+	if !nt.Valid || !date.Valid {
+		return NullTime{}
+	}
+
+	return NullTime{Time: nt.TimeOfDay.On(date.Date, time.FixedZone("", nt.Offset)), Valid: true}
+}
+
+func scanTimeOfDay(target string, value interface{}) (TimeOfDay, error) {
+	var (
+		res TimeOfDay
+		err error
+	)
+
+	switch t := value.(type) {
+	case time.Time:
+		return TimeOfDayOf(t), nil
+
+	case time.Duration:
+		res, err = TimeOfDayFromDuration(t)
+		if err != nil {
+			return TimeOfDay{}, NewScanError(target, value, err)
+		}
+		return res, nil
+
+	case string:
+		res, err = ParseTimeOfDay(t)
+
+	case []byte:
+		res, err = ParseTimeOfDay(string(t))
+
+	default:
+		return TimeOfDay{}, unsupportedSourceError(target, value)
+	}
+
+	if err != nil {
+		return TimeOfDay{}, parseError(target, value, err)
+	}
+
+	return res, nil
+}
+
+// parseTimeOfDayTZ parses a time of day followed by a UTC offset in the
+// forms "Z", "+07", "+0700", "+07:00" or "+07:00:00".
+func parseTimeOfDayTZ(s string) (TimeOfDay, int, error) {
+	if strings.HasSuffix(s, "Z") {
+		tod, err := ParseTimeOfDay(s[:len(s)-1])
+		return tod, 0, err
+	}
+
+	i := strings.LastIndexAny(s, "+-")
+	if i < 0 {
+		return TimeOfDay{}, 0, fmt.Errorf("missing offset in time of day %q", s)
+	}
+
+	tod, err := ParseTimeOfDay(s[:i])
+	if err != nil {
+		return TimeOfDay{}, 0, err
+	}
+
+	zone := strings.ReplaceAll(s[i+1:], ":", "")
+	if len(zone) != 2 && len(zone) != 4 && len(zone) != 6 {
+		return TimeOfDay{}, 0, fmt.Errorf("invalid offset in time of day %q", s)
+	}
+
+	offset := 0
+	for j, unit := range []int{3600, 60, 1} {
+		if 2*j >= len(zone) {
+			break
+		}
+
+		n, err := strconv.Atoi(zone[2*j : 2*j+2])
+		if err != nil {
+			return TimeOfDay{}, 0, fmt.Errorf("invalid offset in time of day %q", s)
+		}
+
+		offset += n * unit
+	}
+
+	if s[i] == '-' {
+		offset = -offset
+	}
+
+	return tod, offset, nil
+}
@@ -0,0 +1,213 @@
+package nullish
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		in   string
+		want TimeOfDay
+	}{
+		{"15:04", TimeOfDay{Hour: 15, Minute: 4}},
+		{"15:04:05", TimeOfDay{Hour: 15, Minute: 4, Second: 5}},
+		{"15:04:05.5", TimeOfDay{Hour: 15, Minute: 4, Second: 5, Microsecond: 500000}},
+		{"15:04:05.123456789", TimeOfDay{Hour: 15, Minute: 4, Second: 5, Microsecond: 123456}},
+		{"24:00:00", TimeOfDay{Hour: 24}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTimeOfDay(tt.in)
+		if err != nil {
+			t.Fatalf("ParseTimeOfDay(%q): unexpected error: %v", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseTimeOfDay(%q): expected %+v, got %+v", tt.in, tt.want, got)
+		}
+	}
+
+	for _, in := range []string{"", "15", "25:00", "24:00:01", "15:60", "15:04:05.x", "1:2:3:4", "noon"} {
+		if _, err := ParseTimeOfDay(in); err == nil {
+			t.Errorf("ParseTimeOfDay(%q): expected error", in)
+		}
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	tod := TimeOfDay{Hour: 9, Minute: 30, Second: 0, Microsecond: 120}
+
+	if got := tod.String(); got != "09:30:00.00012" {
+		t.Errorf("String: expected 09:30:00.00012, got %s", got)
+	}
+	if got := tod.Duration(); got != 9*time.Hour+30*time.Minute+120*time.Microsecond {
+		t.Errorf("Duration: unexpected %v", got)
+	}
+
+	back, err := TimeOfDayFromDuration(tod.Duration())
+	if err != nil || back != tod {
+		t.Errorf("TimeOfDayFromDuration: expected %+v, got %+v err=%v", tod, back, err)
+	}
+	if _, err := TimeOfDayFromDuration(25 * time.Hour); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+
+	noon := TimeOfDay{Hour: 12}
+	if !tod.Before(noon) || !noon.After(tod) || tod.Compare(tod) != 0 {
+		t.Error("unexpected comparison result")
+	}
+}
+
+func TestNullTimeOfDay_ValueScan(t *testing.T) {
+	nt := NewNullTimeOfDay(TimeOfDay{Hour: 15, Minute: 4, Second: 5, Microsecond: 999999}, true)
+	got, err := nt.Value()
+	if err != nil || got != "15:04:05.999999" {
+		t.Errorf("expected 15:04:05.999999, got %v err=%v", got, err)
+	}
+
+	got, err = NullTimeOfDay{}.Value()
+	if err != nil || got != nil {
+		t.Errorf("expected nil, got %v err=%v", got, err)
+	}
+
+	want := TimeOfDay{Hour: 15, Minute: 4, Second: 5}
+
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"string", "15:04:05"},
+		{"bytes", []byte("15:04:05")},
+		{"time", time.Date(2024, 5, 1, 15, 4, 5, 999, time.UTC)},
+		{"duration", 15*time.Hour + 4*time.Minute + 5*time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nt NullTimeOfDay
+			if err := nt.Scan(tt.value); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if nt.TimeOfDay != want || !nt.Valid {
+				t.Errorf("expected %+v, got %+v", want, nt)
+			}
+		})
+	}
+
+	if err := nt.Scan("25:00:00"); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+	if err := nt.Scan(-time.Second); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+	if err := nt.Scan(int64(1)); !errors.Is(err, ErrUnsupportedSource) {
+		t.Errorf("expected unsupported source error, got %v", err)
+	}
+	if err := nt.Scan(nil); err != nil || nt.Valid {
+		t.Errorf("expected Valid=false for nil, got %+v err=%v", nt, err)
+	}
+}
+
+func TestNullTimeOfDay_JSON(t *testing.T) {
+	nt := NewNullTimeOfDay(TimeOfDay{Hour: 15, Minute: 4, Second: 5, Microsecond: 250000}, true)
+
+	data, err := json.Marshal(nt)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `"15:04:05.25"` {
+		t.Errorf(`expected "15:04:05.25", got %s`, data)
+	}
+
+	var decoded NullTimeOfDay
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != nt {
+		t.Errorf("roundtrip failed: expected %+v, got %+v err=%v", nt, decoded, err)
+	}
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded.Valid {
+		t.Errorf("expected Valid=false for null, got %+v err=%v", decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`"later"`), &decoded); err == nil {
+		t.Error("expected error for invalid time")
+	}
+	if err := json.Unmarshal([]byte(`""`), &decoded); err == nil {
+		t.Error("expected error for empty string")
+	}
+}
+
+func TestNullTimeOfDayTZ(t *testing.T) {
+	tests := []struct {
+		in     string
+		offset int
+		out    string
+	}{
+		{"15:04:05+07", 7 * 3600, "15:04:05+07:00"},
+		{"15:04:05.5-05:30", -(5*3600 + 30*60), "15:04:05.5-05:30"},
+		{"15:04:05+0100", 3600, "15:04:05+01:00"},
+		{"15:04:05Z", 0, "15:04:05+00:00"},
+	}
+
+	for _, tt := range tests {
+		var nt NullTimeOfDayTZ
+		if err := nt.Scan(tt.in); err != nil {
+			t.Fatalf("Scan(%q): unexpected error: %v", tt.in, err)
+		}
+		if nt.Offset != tt.offset || !nt.Valid {
+			t.Errorf("Scan(%q): expected offset %d, got %+v", tt.in, tt.offset, nt)
+		}
+
+		got, err := nt.Value()
+		if err != nil || got != tt.out {
+			t.Errorf("Value(%q): expected %s, got %v err=%v", tt.in, tt.out, got, err)
+		}
+	}
+
+	var nt NullTimeOfDayTZ
+	if err := nt.Scan(time.Date(2024, 5, 1, 15, 4, 5, 0, time.FixedZone("WIB", 7*3600))); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nt.Offset != 7*3600 || nt.TimeOfDay != (TimeOfDay{Hour: 15, Minute: 4, Second: 5}) {
+		t.Errorf("unexpected value %+v", nt)
+	}
+
+	data, err := json.Marshal(nt)
+	if err != nil || string(data) != `"15:04:05+07:00"` {
+		t.Errorf(`expected "15:04:05+07:00", got %s err=%v`, data, err)
+	}
+
+	var decoded NullTimeOfDayTZ
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != nt {
+		t.Errorf("roundtrip failed: expected %+v, got %+v err=%v", nt, decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`""`), &decoded); err == nil {
+		t.Error("expected error for empty string")
+	}
+
+	if err := nt.Scan("15:04:05"); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error for missing offset, got %v", err)
+	}
+	if err := nt.Scan(nil); err != nil || nt.Valid || nt.Offset != 0 {
+		t.Errorf("expected reset for nil, got %+v err=%v", nt, err)
+	}
+}
+
+func TestNullTimeOfDay_On(t *testing.T) {
+	date := NewNullDate(Date{Year: 2024, Month: 5, Day: 1}, true)
+	jakarta := time.FixedZone("WIB", 7*3600)
+
+	nt := NewNullTimeOfDay(TimeOfDay{Hour: 9, Minute: 30}, true).On(date, jakarta)
+	if !nt.Valid || !nt.Time.Equal(time.Date(2024, 5, 1, 2, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected time %+v", nt)
+	}
+
+	ntz := NewNullTimeOfDayTZ(TimeOfDay{Hour: 9, Minute: 30}, 7*3600, true).On(date)
+	if !ntz.Valid || !ntz.Time.Equal(nt.Time) {
+		t.Errorf("unexpected time %+v", ntz)
+	}
+
+	if (NullTimeOfDay{}).On(date, jakarta).Valid || NewNullTimeOfDay(TimeOfDay{}, true).On(NullDate{}, jakarta).Valid {
+		t.Error("expected null result when either value is null")
+	}
+}
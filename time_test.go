@@ -1,6 +1,7 @@
 ﻿package nullish
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -128,6 +129,294 @@ func TestNullTime_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestNullTime_ScanText(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  time.Time
+	}{
+		{"rfc3339", "2024-01-02T15:04:05.5+07:00", time.Date(2024, 1, 2, 8, 4, 5, 500000000, time.UTC)},
+		{"sql datetime bytes", []byte("2024-01-02 15:04:05"), time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"sql datetime fraction", "2024-01-02 15:04:05.123456", time.Date(2024, 1, 2, 15, 4, 5, 123456000, time.UTC)},
+		{"postgres offset", "2024-01-02 15:04:05+02", time.Date(2024, 1, 2, 13, 4, 5, 0, time.UTC)},
+		{"sqlite offset", "2024-01-02 15:04:05.999999999+00:00", time.Date(2024, 1, 2, 15, 4, 5, 999999999, time.UTC)},
+		{"date only", "2024-01-02", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"epoch seconds", int64(1704207845), time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nt NullTime
+			err := nt.Scan(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !nt.Time.Equal(tt.want) || !nt.Valid {
+				t.Errorf("expected Time=%v Valid=true, got Time=%v Valid=%v", tt.want, nt.Time, nt.Valid)
+			}
+		})
+	}
+
+	var nt NullTime
+	err := nt.Scan([]byte("yesterday"))
+	if !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+}
+
+func TestNullTime_ScanConfig(t *testing.T) {
+	defer func(unit time.Duration, loc *time.Location) {
+		TimeEpochUnit, TimeLocation = unit, loc
+	}(TimeEpochUnit, TimeLocation)
+
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	TimeEpochUnit = time.Millisecond
+	TimeLocation = jakarta
+
+	var nt NullTime
+	err := nt.Scan(int64(1704207845123))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !nt.Time.Equal(time.Date(2024, 1, 2, 15, 4, 5, 123000000, time.UTC)) {
+		t.Errorf("unexpected time %v", nt.Time)
+	}
+	if nt.Time.Location() != jakarta {
+		t.Errorf("expected location WIB, got %v", nt.Time.Location())
+	}
+
+	// Zone-less text is read in the package location
+	err = nt.Scan("2024-01-02 15:04:05")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !nt.Time.Equal(time.Date(2024, 1, 2, 8, 4, 5, 0, time.UTC)) {
+		t.Errorf("unexpected time %v", nt.Time)
+	}
+
+	// Per-instance location wins over the package location
+	ni := NullTimeIn{Location: time.UTC}
+	err = ni.Scan("2024-01-02 15:04:05")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ni.Time.Equal(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)) || !ni.Valid {
+		t.Errorf("unexpected time %+v", ni)
+	}
+	if err := ni.Scan(int64(1704207845123)); err != nil || ni.Time.Location() != time.UTC {
+		t.Errorf("expected epoch in UTC, got %v err=%v", ni.Time, err)
+	}
+	if err := ni.Scan(nil); err != nil || ni.Valid || ni.Location != time.UTC {
+		t.Errorf("expected null keeping Location, got %+v err=%v", ni, err)
+	}
+
+	// Zone-less JSON and text follow the instance location too
+	defer func(f TimeFormat) { DefaultTimeFormat = f }(DefaultTimeFormat)
+	DefaultTimeFormat = TimeFormat{Layouts: []string{time.DateTime}, Output: time.DateTime}
+
+	if err := json.Unmarshal([]byte(`"2024-01-02 15:04:05"`), &ni); err != nil || !ni.Time.Equal(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)) {
+		t.Errorf("unexpected JSON time %v err=%v", ni.Time, err)
+	}
+	if err := ni.UnmarshalText([]byte("2024-01-02 15:04:05")); err != nil || ni.Time.Location() != time.UTC {
+		t.Errorf("unexpected text time %v err=%v", ni.Time, err)
+	}
+	if data, err := json.Marshal(NewNullTimeIn(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC), jakarta, true)); err != nil || string(data) != `"2024-01-02 15:04:05"` {
+		t.Errorf("unexpected JSON %s err=%v", data, err)
+	}
+	if err := json.Unmarshal([]byte("null"), &ni); err != nil || ni.Valid || ni.Location != time.UTC {
+		t.Errorf("expected null keeping Location, got %+v err=%v", ni, err)
+	}
+
+	TimeEpochUnit = time.Nanosecond
+	err = nt.Scan(int64(1500))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !nt.Time.Equal(time.Unix(0, 1500)) {
+		t.Errorf("unexpected time %v", nt.Time)
+	}
+
+	TimeEpochUnit = time.Hour
+	err = nt.Scan(int64(2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !nt.Time.Equal(time.Unix(7200, 0)) {
+		t.Errorf("unexpected time %v", nt.Time)
+	}
+}
+
+func TestNullTime_Normalization(t *testing.T) {
+	defer func(n TimeNormalization) { DefaultTimeNormalization = n }(DefaultTimeNormalization)
+
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	local := time.Date(2024, 5, 1, 17, 0, 0, 123456789, jakarta)
+
+	DefaultTimeNormalization = TimeNormalization{Truncate: time.Microsecond, Location: time.UTC, StripMonotonic: true}
+
+	got, err := NewNullTime(local, true).Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC); got != want {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	var nt NullTime
+	if err := nt.Scan(local); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nt.Time != time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC) {
+		t.Errorf("unexpected scanned time %v", nt.Time)
+	}
+
+	if err := nt.Scan("2024-05-01 17:00:00.1234567"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nt.Time != time.Date(2024, 5, 1, 17, 0, 0, 123456000, time.UTC) {
+		t.Errorf("unexpected scanned time %v", nt.Time)
+	}
+
+	// time.Now carries a monotonic reading that == comparisons see
+	now := time.Now()
+	got, err = NewNullTime(now, true).Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.(time.Time) != got.(time.Time).Round(0) {
+		t.Error("expected monotonic reading to be stripped")
+	}
+
+	// A round-trip through a microsecond database compares equal
+	stored := NewNullTime(now.Truncate(time.Microsecond).In(time.UTC), true)
+	if !NewNullTime(now, true).Equal(stored) {
+		t.Error("expected normalized times to be equal")
+	}
+
+	DefaultTimeNormalization = TimeNormalization{}
+	if NewNullTime(time.Unix(0, 1500), true).Equal(NewNullTime(time.Unix(0, 1000), true)) {
+		t.Error("expected nanosecond difference without normalization")
+	}
+	if !(NullTime{}).Equal(NullTime{Time: now}) {
+		t.Error("expected null values to be equal")
+	}
+	if NewNullTime(now, true).Equal(NullTime{}) {
+		t.Error("expected valid and null values to differ")
+	}
+}
+
+type apiTime struct{}
+
+func (apiTime) TimeFormat() TimeFormat {
+	return TimeFormat{
+		Layouts:   []string{time.DateTime, time.DateOnly},
+		Output:    time.DateTime,
+		UTC:       true,
+		EpochUnit: time.Millisecond,
+	}
+}
+
+func TestNullTime_DefaultTimeFormat(t *testing.T) {
+	defer func(format TimeFormat) { DefaultTimeFormat = format }(DefaultTimeFormat)
+
+	DefaultTimeFormat = TimeFormat{Layouts: TimeLayouts, UTC: true, EpochUnit: time.Second}
+
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{`"2024-05-01"`, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{`"2024-05-01 10:00:00"`, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)},
+		{`"2024-05-01T10:00:00+07:00"`, time.Date(2024, 5, 1, 3, 0, 0, 0, time.UTC)},
+		{`1714557600`, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)},
+		{`"1714557600"`, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		var nt NullTime
+		if err := json.Unmarshal([]byte(tt.in), &nt); err != nil {
+			t.Fatalf("unmarshal %s: %v", tt.in, err)
+		}
+		if !nt.Time.Equal(tt.want) || !nt.Valid {
+			t.Errorf("unmarshal %s: expected %v, got %+v", tt.in, tt.want, nt)
+		}
+		if nt.Time.Location() != time.UTC {
+			t.Errorf("unmarshal %s: expected UTC, got %v", tt.in, nt.Time.Location())
+		}
+	}
+
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	nt := NewNullTime(time.Date(2024, 5, 1, 17, 0, 0, 0, jakarta), true)
+
+	data, err := json.Marshal(nt)
+	if err != nil || string(data) != `"2024-05-01T10:00:00Z"` {
+		t.Errorf(`expected "2024-05-01T10:00:00Z", got %s err=%v`, data, err)
+	}
+
+	text, err := nt.MarshalText()
+	if err != nil || string(text) != "2024-05-01T10:00:00Z" {
+		t.Errorf("expected 2024-05-01T10:00:00Z, got %s err=%v", text, err)
+	}
+
+	var decoded NullTime
+	if err := decoded.UnmarshalText([]byte("1714557600")); err != nil || !decoded.Time.Equal(nt.Time) {
+		t.Errorf("expected %v, got %+v err=%v", nt.Time, decoded, err)
+	}
+
+	// The default stays strict RFC3339Nano
+	DefaultTimeFormat = TimeFormat{Layouts: []string{time.RFC3339Nano}, Output: time.RFC3339Nano}
+	if err := json.Unmarshal([]byte(`"2024-05-01"`), &decoded); err == nil {
+		t.Error("expected error for date only input")
+	}
+	if err := json.Unmarshal([]byte(`1714557600`), &decoded); err == nil {
+		t.Error("expected error for numeric input")
+	}
+}
+
+func TestNullTimeAs(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	nt := NewNullTimeAs[apiTime](time.Date(2024, 5, 1, 17, 0, 0, 0, jakarta), true)
+
+	data, err := json.Marshal(nt)
+	if err != nil || string(data) != `"2024-05-01 10:00:00"` {
+		t.Errorf(`expected "2024-05-01 10:00:00", got %s err=%v`, data, err)
+	}
+
+	for _, in := range []string{`"2024-05-01 10:00:00"`, `1714557600000`} {
+		var decoded NullTimeAs[apiTime]
+		if err := json.Unmarshal([]byte(in), &decoded); err != nil {
+			t.Fatalf("unmarshal %s: %v", in, err)
+		}
+		if !decoded.Time.Equal(nt.Time) || !decoded.Valid {
+			t.Errorf("unmarshal %s: expected %v, got %+v", in, nt.Time, decoded)
+		}
+	}
+
+	var decoded NullTimeAs[apiTime]
+	if err := json.Unmarshal([]byte(`"2024-05-01T10:00:00Z"`), &decoded); err == nil {
+		t.Error("expected error for layout outside the format")
+	}
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded.Valid {
+		t.Errorf("expected Valid=false for null, got %+v err=%v", decoded, err)
+	}
+
+	text, err := nt.MarshalText()
+	if err != nil || string(text) != "2024-05-01 10:00:00" {
+		t.Errorf("expected 2024-05-01 10:00:00, got %s err=%v", text, err)
+	}
+
+	// Database access is the one of NullTime
+	if err := decoded.Scan("2024-05-01T10:00:00Z"); err != nil || !decoded.Time.Equal(nt.Time) {
+		t.Errorf("expected %v, got %+v err=%v", nt.Time, decoded, err)
+	}
+	got, err := decoded.Value()
+	if err != nil || got != decoded.Time {
+		t.Errorf("expected %v, got %v err=%v", decoded.Time, got, err)
+	}
+}
+
 func BenchmarkNullTime_Value(b *testing.B) {
 	nt := NewNullTime(time.Now(), true)
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,369 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Uint64Policy selects how NullUint64.Value handles values above
+// math.MaxInt64, which driver.Value cannot carry as an int64.
+type Uint64Policy int
+
+const (
+	// Uint64PolicyError makes Value fail, matching database/sql.
+	Uint64PolicyError Uint64Policy = iota
+
+	// Uint64PolicyString makes Value return the decimal string, suitable
+	// for NUMERIC(20,0) columns.
+	Uint64PolicyString
+)
+
+// Uint64ValuePolicy is the policy applied by NullUint64.Value.
+var Uint64ValuePolicy = Uint64PolicyError
+
+// The unsigned integer types reject negative values and values that do not
+// fit with ErrOverflow in Scan, and with a *strconv.NumError in UnmarshalJSON.
+
+// NullUint8 is a nullable uint8.
+type NullUint8 struct {
+	Uint8 uint8
+	Valid bool
+}
+
+// Value method
+func (nu NullUint8) Value() (driver.Value, error) {
+
+	if !nu.Valid {
+		return nil, nil
+	}
+
+	return int64(nu.Uint8), nil
+}
+
+// Scan method
+func (nu *NullUint8) Scan(value interface{}) error {
+
+	if value == nil {
+		nu.Uint8, nu.Valid = 0, false
+		return nil
+	}
+
+	res, err := toUintN(value, 8)
+	if err != nil {
+		return NewScanError("NullUint8", value, err)
+	}
+
+	nu.Uint8, nu.Valid = uint8(res), true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nu NullUint8) MarshalJSON() ([]byte, error) {
+	return NewNull(nu.Uint8, nu.Valid).MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (nu *NullUint8) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nu = NullUint8{}
+		return nil
+	}
+
+	res, err := unmarshalUint(data, 8)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUint8{Uint8: uint8(res), Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nu NullUint8) MarshalText() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatUint(uint64(nu.Uint8), 10)), nil
+}
+
+// UnmarshalText method
+func (nu *NullUint8) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nu = NullUint8{}
+		return nil
+	}
+
+	res, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUint8{Uint8: uint8(res), Valid: true}
+
+	return nil
+}
+
+// NullUint16 is a nullable uint16.
+type NullUint16 struct {
+	Uint16 uint16
+	Valid  bool
+}
+
+// Value method
+func (nu NullUint16) Value() (driver.Value, error) {
+
+	if !nu.Valid {
+		return nil, nil
+	}
+
+	return int64(nu.Uint16), nil
+}
+
+// Scan method
+func (nu *NullUint16) Scan(value interface{}) error {
+
+	if value == nil {
+		nu.Uint16, nu.Valid = 0, false
+		return nil
+	}
+
+	res, err := toUintN(value, 16)
+	if err != nil {
+		return NewScanError("NullUint16", value, err)
+	}
+
+	nu.Uint16, nu.Valid = uint16(res), true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nu NullUint16) MarshalJSON() ([]byte, error) {
+	return NewNull(nu.Uint16, nu.Valid).MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (nu *NullUint16) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nu = NullUint16{}
+		return nil
+	}
+
+	res, err := unmarshalUint(data, 16)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUint16{Uint16: uint16(res), Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nu NullUint16) MarshalText() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatUint(uint64(nu.Uint16), 10)), nil
+}
+
+// UnmarshalText method
+func (nu *NullUint16) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nu = NullUint16{}
+		return nil
+	}
+
+	res, err := strconv.ParseUint(string(text), 10, 16)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUint16{Uint16: uint16(res), Valid: true}
+
+	return nil
+}
+
+// NullUint32 is a nullable uint32.
+type NullUint32 struct {
+	Uint32 uint32
+	Valid  bool
+}
+
+// Value method
+func (nu NullUint32) Value() (driver.Value, error) {
+
+	if !nu.Valid {
+		return nil, nil
+	}
+
+	return int64(nu.Uint32), nil
+}
+
+// Scan method
+func (nu *NullUint32) Scan(value interface{}) error {
+
+	if value == nil {
+		nu.Uint32, nu.Valid = 0, false
+		return nil
+	}
+
+	res, err := toUintN(value, 32)
+	if err != nil {
+		return NewScanError("NullUint32", value, err)
+	}
+
+	nu.Uint32, nu.Valid = uint32(res), true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nu NullUint32) MarshalJSON() ([]byte, error) {
+	return NewNull(nu.Uint32, nu.Valid).MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (nu *NullUint32) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nu = NullUint32{}
+		return nil
+	}
+
+	res, err := unmarshalUint(data, 32)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUint32{Uint32: uint32(res), Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nu NullUint32) MarshalText() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatUint(uint64(nu.Uint32), 10)), nil
+}
+
+// UnmarshalText method
+func (nu *NullUint32) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nu = NullUint32{}
+		return nil
+	}
+
+	res, err := strconv.ParseUint(string(text), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUint32{Uint32: uint32(res), Valid: true}
+
+	return nil
+}
+
+// NullUint64 is a nullable uint64. See Uint64ValuePolicy for how Value
+// handles values above math.MaxInt64.
+type NullUint64 struct {
+	Uint64 uint64
+	Valid  bool
+}
+
+// Value method
+func (nu NullUint64) Value() (driver.Value, error) {
+
+	if !nu.Valid {
+		return nil, nil
+	}
+
+	if nu.Uint64 > math.MaxInt64 {
+		if Uint64ValuePolicy == Uint64PolicyString {
+			return strconv.FormatUint(nu.Uint64, 10), nil
+		}
+
+		return nil, fmt.Errorf("%w: uint64 value %d overflows int64", ErrOverflow, nu.Uint64)
+	}
+
+	return int64(nu.Uint64), nil
+}
+
+// Scan method
+func (nu *NullUint64) Scan(value interface{}) error {
+
+	if value == nil {
+		nu.Uint64, nu.Valid = 0, false
+		return nil
+	}
+
+	res, err := toUintN(value, 64)
+	if err != nil {
+		return NewScanError("NullUint64", value, err)
+	}
+
+	nu.Uint64, nu.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nu NullUint64) MarshalJSON() ([]byte, error) {
+	return NewNull(nu.Uint64, nu.Valid).MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (nu *NullUint64) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nu = NullUint64{}
+		return nil
+	}
+
+	res, err := unmarshalUint(data, 64)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUint64{Uint64: res, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nu NullUint64) MarshalText() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatUint(nu.Uint64, 10)), nil
+}
+
+// UnmarshalText method
+func (nu *NullUint64) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nu = NullUint64{}
+		return nil
+	}
+
+	res, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUint64{Uint64: res, Valid: true}
+
+	return nil
+}
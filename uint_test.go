@@ -0,0 +1,123 @@
+package nullish
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/goccy/go-json"
+)
+
+func TestNullUint64_Value(t *testing.T) {
+	nu := NewNullUint64(42, true)
+	got, err := nu.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != int64(42) {
+		t.Errorf("expected 42, got %v", got)
+	}
+
+	// Values above MaxInt64 fail by default
+	nu = NewNullUint64(math.MaxUint64, true)
+	_, err = nu.Value()
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error for value above MaxInt64, got %v", err)
+	}
+
+	defer func(policy Uint64Policy) { Uint64ValuePolicy = policy }(Uint64ValuePolicy)
+	Uint64ValuePolicy = Uint64PolicyString
+
+	got, err = nu.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "18446744073709551615" {
+		t.Errorf("expected decimal string, got %v", got)
+	}
+
+	nu = NewNullUint64(0, false)
+	got, err = nu.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
+
+func TestNullUintN_Scan(t *testing.T) {
+	var n8 NullUint8
+	if err := n8.Scan(int64(255)); err != nil || n8.Uint8 != 255 || !n8.Valid {
+		t.Errorf("expected Uint8=255, got %+v err=%v", n8, err)
+	}
+	if err := n8.Scan(int64(256)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow for 256, got %v", err)
+	}
+	if err := n8.Scan(int64(-1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow for -1, got %v", err)
+	}
+
+	var n16 NullUint16
+	if err := n16.Scan([]byte("65535")); err != nil || n16.Uint16 != 65535 {
+		t.Errorf("expected Uint16=65535, got %+v err=%v", n16, err)
+	}
+	if err := n16.Scan("-1"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow for -1, got %v", err)
+	}
+
+	var n32 NullUint32
+	if err := n32.Scan(float64(1.5)); !errors.Is(err, ErrPrecision) {
+		t.Errorf("expected precision error, got %v", err)
+	}
+	if err := n32.Scan("abc"); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+
+	var n64 NullUint64
+	if err := n64.Scan("18446744073709551615"); err != nil || n64.Uint64 != math.MaxUint64 {
+		t.Errorf("expected Uint64=MaxUint64, got %+v err=%v", n64, err)
+	}
+	if err := n64.Scan("18446744073709551616"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow, got %v", err)
+	}
+	if err := n64.Scan("18446744073709551615.00"); err != nil || n64.Uint64 != math.MaxUint64 {
+		t.Errorf("expected Uint64=MaxUint64, got %+v err=%v", n64, err)
+	}
+	if err := n64.Scan("9007199254740993.5"); !errors.Is(err, ErrPrecision) {
+		t.Errorf("expected precision error, got %v", err)
+	}
+}
+
+func TestNullUintN_JSON(t *testing.T) {
+	n64 := NewNullUint64(math.MaxUint64, true)
+	data, err := json.Marshal(n64)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != "18446744073709551615" {
+		t.Errorf("expected 18446744073709551615, got %s", data)
+	}
+
+	var decoded NullUint64
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	if decoded != n64 {
+		t.Errorf("roundtrip failed: expected %+v, got %+v", n64, decoded)
+	}
+
+	if err := json.Unmarshal([]byte("18446744073709551616"), &decoded); err == nil {
+		t.Error("expected error for out of range uint64")
+	}
+
+	var n16 NullUint16
+	if err := json.Unmarshal([]byte("-1"), &n16); err == nil {
+		t.Error("expected error for negative uint16")
+	}
+
+	if err := n16.UnmarshalText([]byte("70000")); err == nil {
+		t.Error("expected error for out of range uint16")
+	}
+}
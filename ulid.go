@@ -3,9 +3,7 @@ package nullish
 import (
 	"bytes"
 	"database/sql/driver"
-	"errors"
 
-	"github.com/goccy/go-json"
 	"github.com/oklog/ulid/v2"
 )
 
@@ -16,12 +14,7 @@ type NullULID struct {
 
 // Value method
 func (nl NullULID) Value() (driver.Value, error) {
-
-	if !nl.Valid {
-		return nil, nil
-	}
-
-	return nl.ULID.Value()
+	return NewNull(nl.ULID, nl.Valid).Value()
 }
 
 // Scan method
@@ -35,8 +28,13 @@ func (nl *NullULID) Scan(value interface{}) error {
 	err := nl.ULID.Scan(value)
 	if err != nil {
 		nl.ULID, nl.Valid = ulid.ULID{}, false
-		return errors.New("scan ulid is failed")
 
+		switch value.(type) {
+		case string, []byte:
+			return parseError("NullULID", value, err)
+		default:
+			return unsupportedSourceError("NullULID", value)
+		}
 	}
 
 	nl.Valid = true
@@ -46,27 +44,48 @@ func (nl *NullULID) Scan(value interface{}) error {
 
 // MarshalJSON method
 func (nl NullULID) MarshalJSON() ([]byte, error) {
+	return NewNull(nl.ULID, nl.Valid).MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (nl *NullULID) UnmarshalJSON(data []byte) error {
+	var res Null[ulid.ULID]
+
+	err := res.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	*nl = NullULID{ULID: res.V, Valid: res.Valid}
+
+	return nil
+}
+
+// MarshalText method
+func (nl NullULID) MarshalText() ([]byte, error) {
 
 	if !nl.Valid {
-		return NullType, nil
+		return NullText, nil
 	}
 
-	return json.Marshal(nl.ULID)
+	return nl.ULID.MarshalText()
 }
 
-// UnmarshalJSON method
-func (nl *NullULID) UnmarshalJSON(data []byte) error {
-	if bytes.Equal(data, NullType) {
+// UnmarshalText method
+func (nl *NullULID) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
 		*nl = NullULID{}
 		return nil
 	}
 
-	err := json.Unmarshal(data, &nl.ULID)
+	var res NullULID
+
+	err := res.ULID.UnmarshalText(text)
 	if err != nil {
 		return err
 	}
 
-	nl.Valid = true
+	*nl = NullULID{ULID: res.ULID, Valid: true}
 
 	return nil
 }
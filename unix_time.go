@@ -0,0 +1,350 @@
+package nullish
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// The Unix time types hold a time.Time that is written to JSON and text as
+// an integer count of seconds, milliseconds or nanoseconds since the Unix
+// epoch, as many partner APIs do. UnmarshalJSON also accepts the count as a
+// numeric string. The database side matches NullTime: Value writes a
+// time.Time and Scan accepts time.Time, TimeLayouts text and integer
+// counts in the unit of the type, assigned TimeLocation, and both apply
+// DefaultTimeNormalization.
+
+// NullUnixTime is a nullable time.Time encoded as integer seconds since the Unix epoch.
+type NullUnixTime struct {
+	Time  time.Time
+	Valid bool
+}
+
+// Value method
+func (nu NullUnixTime) Value() (driver.Value, error) {
+
+	if !nu.Valid {
+		return nil, nil
+	}
+
+	return DefaultTimeNormalization.Apply(nu.Time), nil
+}
+
+// Scan method
+func (nu *NullUnixTime) Scan(value interface{}) error {
+
+	if value == nil {
+		nu.Time, nu.Valid = time.Time{}, false
+		return nil
+	}
+
+	res, err := scanEpoch("NullUnixTime", value, time.Second)
+	if err != nil {
+		return err
+	}
+
+	nu.Time, nu.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nu NullUnixTime) MarshalJSON() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullType, nil
+	}
+
+	return []byte(strconv.FormatInt(nu.Time.Unix(), 10)), nil
+}
+
+// UnmarshalJSON method
+func (nu *NullUnixTime) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nu = NullUnixTime{}
+		return nil
+	}
+
+	res, err := unmarshalEpoch(data, time.Second)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUnixTime{Time: res, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nu NullUnixTime) MarshalText() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatInt(nu.Time.Unix(), 10)), nil
+}
+
+// UnmarshalText method
+func (nu *NullUnixTime) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nu = NullUnixTime{}
+		return nil
+	}
+
+	res, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUnixTime{Time: epochTime(res, time.Second).In(TimeLocation), Valid: true}
+
+	return nil
+}
+
+// NullUnixMilli is a nullable time.Time encoded as integer milliseconds since the Unix epoch.
+type NullUnixMilli struct {
+	Time  time.Time
+	Valid bool
+}
+
+// Value method
+func (nu NullUnixMilli) Value() (driver.Value, error) {
+
+	if !nu.Valid {
+		return nil, nil
+	}
+
+	return DefaultTimeNormalization.Apply(nu.Time), nil
+}
+
+// Scan method
+func (nu *NullUnixMilli) Scan(value interface{}) error {
+
+	if value == nil {
+		nu.Time, nu.Valid = time.Time{}, false
+		return nil
+	}
+
+	res, err := scanEpoch("NullUnixMilli", value, time.Millisecond)
+	if err != nil {
+		return err
+	}
+
+	nu.Time, nu.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nu NullUnixMilli) MarshalJSON() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullType, nil
+	}
+
+	return []byte(strconv.FormatInt(nu.Time.UnixMilli(), 10)), nil
+}
+
+// UnmarshalJSON method
+func (nu *NullUnixMilli) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nu = NullUnixMilli{}
+		return nil
+	}
+
+	res, err := unmarshalEpoch(data, time.Millisecond)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUnixMilli{Time: res, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nu NullUnixMilli) MarshalText() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatInt(nu.Time.UnixMilli(), 10)), nil
+}
+
+// UnmarshalText method
+func (nu *NullUnixMilli) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nu = NullUnixMilli{}
+		return nil
+	}
+
+	res, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUnixMilli{Time: epochTime(res, time.Millisecond).In(TimeLocation), Valid: true}
+
+	return nil
+}
+
+// NullUnixNano is a nullable time.Time encoded as integer nanoseconds since the Unix epoch.
+type NullUnixNano struct {
+	Time  time.Time
+	Valid bool
+}
+
+// Value method
+func (nu NullUnixNano) Value() (driver.Value, error) {
+
+	if !nu.Valid {
+		return nil, nil
+	}
+
+	return DefaultTimeNormalization.Apply(nu.Time), nil
+}
+
+// Scan method
+func (nu *NullUnixNano) Scan(value interface{}) error {
+
+	if value == nil {
+		nu.Time, nu.Valid = time.Time{}, false
+		return nil
+	}
+
+	res, err := scanEpoch("NullUnixNano", value, time.Nanosecond)
+	if err != nil {
+		return err
+	}
+
+	nu.Time, nu.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nu NullUnixNano) MarshalJSON() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullType, nil
+	}
+
+	return []byte(strconv.FormatInt(nu.Time.UnixNano(), 10)), nil
+}
+
+// UnmarshalJSON method
+func (nu *NullUnixNano) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullType) {
+		*nu = NullUnixNano{}
+		return nil
+	}
+
+	res, err := unmarshalEpoch(data, time.Nanosecond)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUnixNano{Time: res, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nu NullUnixNano) MarshalText() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullText, nil
+	}
+
+	return []byte(strconv.FormatInt(nu.Time.UnixNano(), 10)), nil
+}
+
+// UnmarshalText method
+func (nu *NullUnixNano) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nu = NullUnixNano{}
+		return nil
+	}
+
+	res, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUnixNano{Time: epochTime(res, time.Nanosecond).In(TimeLocation), Valid: true}
+
+	return nil
+}
+
+// scanEpoch scans value into a time for target, reading integers as counts
+// of unit since the Unix epoch.
+func scanEpoch(target string, value interface{}, unit time.Duration) (time.Time, error) {
+	var i int64
+
+	switch t := value.(type) {
+	case int64:
+		i = t
+	case int:
+		i = int64(t)
+	case int32:
+		i = int64(t)
+	case string:
+		res, err := strconv.ParseInt(t, 10, 64)
+		if err != nil {
+			return scanEpochTime(target, value)
+		}
+		i = res
+	case []byte:
+		res, err := strconv.ParseInt(string(t), 10, 64)
+		if err != nil {
+			return scanEpochTime(target, value)
+		}
+		i = res
+	default:
+		return scanEpochTime(target, value)
+	}
+
+	return DefaultTimeNormalization.Apply(epochTime(i, unit).In(TimeLocation)), nil
+}
+
+// scanEpochTime scans a value that is not an epoch count like NullTime,
+// which applies DefaultTimeNormalization, reporting errors for target.
+func scanEpochTime(target string, value interface{}) (time.Time, error) {
+	var nt NullTime
+
+	err := nt.Scan(value)
+	if err != nil {
+		var scanErr *ScanError
+		if errors.As(err, &scanErr) {
+			scanErr.Target = target
+		}
+
+		return time.Time{}, err
+	}
+
+	return nt.Time, nil
+}
+
+// unmarshalEpoch decodes a JSON integer or numeric string counting unit
+// since the Unix epoch.
+func unmarshalEpoch(data []byte, unit time.Duration) (time.Time, error) {
+	if len(data) > 0 && data[0] == '"' {
+		str, err := unmarshalNumber(data)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		data = []byte(str)
+	}
+
+	res, err := unmarshalInt(data, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return epochTime(res, unit).In(TimeLocation), nil
+}
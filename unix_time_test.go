@@ -0,0 +1,165 @@
+package nullish
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var unixFixture = time.Date(2024, 1, 2, 15, 4, 5, 123456789, time.UTC)
+
+func TestNullUnix_JSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    string
+		decoded func(data []byte) (time.Time, error)
+		trunc   time.Duration
+	}{
+		{"seconds", NewNullUnixTime(unixFixture, true), "1704207845", func(data []byte) (time.Time, error) {
+			var nu NullUnixTime
+			err := json.Unmarshal(data, &nu)
+			return nu.Time, err
+		}, time.Second},
+		{"milliseconds", NewNullUnixMilli(unixFixture, true), "1704207845123", func(data []byte) (time.Time, error) {
+			var nu NullUnixMilli
+			err := json.Unmarshal(data, &nu)
+			return nu.Time, err
+		}, time.Millisecond},
+		{"nanoseconds", NewNullUnixNano(unixFixture, true), "1704207845123456789", func(data []byte) (time.Time, error) {
+			var nu NullUnixNano
+			err := json.Unmarshal(data, &nu)
+			return nu.Time, err
+		}, time.Nanosecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, data)
+			}
+
+			for _, in := range []string{tt.want, `"` + tt.want + `"`} {
+				got, err := tt.decoded([]byte(in))
+				if err != nil {
+					t.Fatalf("unmarshal %s: %v", in, err)
+				}
+				if !got.Equal(unixFixture.Truncate(tt.trunc)) {
+					t.Errorf("unmarshal %s: expected %v, got %v", in, unixFixture.Truncate(tt.trunc), got)
+				}
+			}
+		})
+	}
+
+	var nu NullUnixTime
+	if err := json.Unmarshal([]byte("null"), &nu); err != nil || nu.Valid {
+		t.Errorf("expected Valid=false for null, got %+v err=%v", nu, err)
+	}
+	if err := json.Unmarshal([]byte(`"2024-01-02T15:04:05Z"`), &nu); err == nil {
+		t.Error("expected error for RFC 3339 string")
+	}
+	if err := json.Unmarshal([]byte(`1.5`), &nu); err == nil {
+		t.Error("expected error for fractional seconds")
+	}
+
+	data, err := json.Marshal(NullUnixMilli{})
+	if err != nil || string(data) != "null" {
+		t.Errorf("expected null, got %s err=%v", data, err)
+	}
+}
+
+func TestNullUnix_ValueScan(t *testing.T) {
+	nu := NewNullUnixMilli(unixFixture, true)
+	got, err := nu.Value()
+	if err != nil || got != unixFixture {
+		t.Errorf("expected %v, got %v err=%v", unixFixture, got, err)
+	}
+
+	got, err = NullUnixMilli{}.Value()
+	if err != nil || got != nil {
+		t.Errorf("expected nil, got %v err=%v", got, err)
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  time.Time
+	}{
+		{"time", unixFixture, unixFixture},
+		{"integer", int64(1704207845123), unixFixture.Truncate(time.Millisecond)},
+		{"integer text", []byte("1704207845123"), unixFixture.Truncate(time.Millisecond)},
+		{"timestamp text", "2024-01-02 15:04:05", unixFixture.Truncate(time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nu NullUnixMilli
+			if err := nu.Scan(tt.value); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !nu.Time.Equal(tt.want) || !nu.Valid {
+				t.Errorf("expected %v, got %+v", tt.want, nu)
+			}
+		})
+	}
+
+	var ns NullUnixTime
+	if err := ns.Scan(int64(1704207845)); err != nil || !ns.Time.Equal(unixFixture.Truncate(time.Second)) {
+		t.Errorf("expected %v, got %+v err=%v", unixFixture.Truncate(time.Second), ns, err)
+	}
+
+	err = ns.Scan("yesterday")
+	var scanErr *ScanError
+	if !errors.Is(err, ErrParse) || !errors.As(err, &scanErr) || scanErr.Target != "NullUnixTime" {
+		t.Errorf("expected parse error for NullUnixTime, got %v", err)
+	}
+	if err := ns.Scan(nil); err != nil || ns.Valid {
+		t.Errorf("expected Valid=false for nil, got %+v err=%v", ns, err)
+	}
+}
+
+func TestNullUnix_Normalization(t *testing.T) {
+	defer func(n TimeNormalization) { DefaultTimeNormalization = n }(DefaultTimeNormalization)
+
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	DefaultTimeNormalization = TimeNormalization{Truncate: time.Millisecond, Location: jakarta}
+
+	want := time.Date(2024, 1, 2, 22, 4, 5, 123000000, jakarta)
+
+	values := []driver.Valuer{
+		NewNullUnixTime(unixFixture, true),
+		NewNullUnixMilli(unixFixture, true),
+		NewNullUnixNano(unixFixture, true),
+	}
+	for _, v := range values {
+		got, err := v.Value()
+		if err != nil || got != want {
+			t.Errorf("%T: expected %v, got %v err=%v", v, want, got, err)
+		}
+	}
+
+	var nn NullUnixNano
+	for _, src := range []interface{}{unixFixture.UnixNano(), strconv.FormatInt(unixFixture.UnixNano(), 10), unixFixture} {
+		if err := nn.Scan(src); err != nil || nn.Time != want {
+			t.Errorf("Scan(%T): expected %v, got %v err=%v", src, want, nn.Time, err)
+		}
+	}
+
+	var nu NullUnixTime
+	if err := nu.Scan(int32(unixFixture.Unix())); err != nil || nu.Time.Location() != jakarta {
+		t.Errorf("expected time in %v, got %v err=%v", jakarta, nu.Time, err)
+	}
+
+	var nm NullUnixMilli
+	if err := nm.Scan([]byte("1704207845123")); err != nil || nm.Time != want {
+		t.Errorf("expected %v, got %v err=%v", want, nm.Time, err)
+	}
+}
@@ -3,12 +3,123 @@ package nullish
 import (
 	"bytes"
 	"database/sql/driver"
+	"encoding/base64"
+	"encoding/binary"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/goccy/go-json"
 	"github.com/google/uuid"
 )
 
+// UUIDFormat selects the textual form of a UUID.
+type UUIDFormat int
+
+const (
+	// UUIDFormatCanonical is "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+	UUIDFormatCanonical UUIDFormat = iota
+
+	// UUIDFormatCompact is "6ba7b8109dad11d180b400c04fd430c8".
+	UUIDFormatCompact
+
+	// UUIDFormatURN is "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+	UUIDFormatURN
+
+	// UUIDFormatBraced is "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", as
+	// SQL Server and .NET write GUIDs.
+	UUIDFormatBraced
+
+	// UUIDFormatBase64 is the padded standard base64 of the 16 bytes,
+	// "a6e4EJ2tEdGAtADAT9QwyA==".
+	UUIDFormatBase64
+
+	// UUIDFormatBase64URL is the unpadded URL-safe base64 of the 16
+	// bytes, "a6e4EJ2tEdGAtADAT9QwyA".
+	UUIDFormatBase64URL
+)
+
+// UUIDJSONFormat is the form NullUUID.MarshalJSON and MarshalText emit.
+// Decoding accepts every UUIDFormat regardless.
+var UUIDJSONFormat = UUIDFormatCanonical
+
+// ParseUUID parses a UUID in any UUIDFormat.
+func ParseUUID(s string) (uuid.UUID, error) {
+	str := strings.TrimSpace(s)
+
+	if len(str) == 22 || len(str) == 24 {
+		for _, enc := range []*base64.Encoding{
+			base64.StdEncoding, base64.RawStdEncoding,
+			base64.URLEncoding, base64.RawURLEncoding,
+		} {
+			b, err := enc.DecodeString(str)
+			if err == nil && len(b) == 16 {
+				return uuid.UUID(b), nil
+			}
+		}
+
+		return uuid.Nil, fmt.Errorf("invalid base64 UUID %q", s)
+	}
+
+	return uuid.Parse(str)
+}
+
+// FormatUUID returns u in format.
+func FormatUUID(u uuid.UUID, format UUIDFormat) string {
+	switch format {
+	case UUIDFormatCompact:
+		return strings.ReplaceAll(u.String(), "-", "")
+	case UUIDFormatURN:
+		return u.URN()
+	case UUIDFormatBraced:
+		return "{" + u.String() + "}"
+	case UUIDFormatBase64:
+		return base64.StdEncoding.EncodeToString(u[:])
+	case UUIDFormatBase64URL:
+		return base64.RawURLEncoding.EncodeToString(u[:])
+	}
+
+	return u.String()
+}
+
+// ErrUUIDVersion is returned by the validator of AllowUUIDVersions for a
+// UUID of another version.
+var ErrUUIDVersion = errors.New("unexpected UUID version")
+
+// UUIDValidator, when set, is called with every non-null UUID decoded by
+// Scan, UnmarshalJSON and UnmarshalText of NullUUID and NullUUIDBinary. A
+// non-nil error rejects the UUID. Nil accepts every UUID.
+//
+//	nullish.UUIDValidator = nullish.AllowUUIDVersions(4, 7)
+var UUIDValidator func(uuid.UUID) error
+
+// AllowUUIDVersions returns a UUIDValidator that rejects UUIDs of other
+// versions with ErrUUIDVersion.
+func AllowUUIDVersions(versions ...uuid.Version) func(uuid.UUID) error {
+	return func(u uuid.UUID) error {
+		for _, v := range versions {
+			if u.Version() == v {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%w %d", ErrUUIDVersion, u.Version())
+	}
+}
+
+func validateUUID(u uuid.UUID) error {
+	if UUIDValidator == nil {
+		return nil
+	}
+
+	return UUIDValidator(u)
+}
+
+// NullUUID is a nullable uuid.UUID.
+//
+// Scan accepts 16 raw bytes and string or []byte in any UUIDFormat. Value
+// writes the canonical string and JSON follows UUIDJSONFormat.
 type NullUUID struct {
 	UUID  uuid.UUID
 	Valid bool
@@ -16,12 +127,7 @@ type NullUUID struct {
 
 // Value method
 func (nu NullUUID) Value() (driver.Value, error) {
-
-	if !nu.Valid {
-		return nil, nil
-	}
-
-	return nu.UUID.Value()
+	return NewNull(nu.UUID, nu.Valid).Value()
 }
 
 // Scan method
@@ -32,14 +138,38 @@ func (nu *NullUUID) Scan(value interface{}) error {
 		return nil
 	}
 
-	err := nu.UUID.Scan(value)
+	var (
+		res uuid.UUID
+		err error
+	)
+
+	switch t := value.(type) {
+	case string:
+		res, err = ParseUUID(t)
+
+	case []byte:
+		if len(t) == 16 {
+			res = uuid.UUID(t)
+			break
+		}
+		res, err = ParseUUID(string(t))
+
+	default:
+		nu.UUID, nu.Valid = uuid.Nil, false
+		return unsupportedSourceError("NullUUID", value)
+	}
+
 	if err != nil {
 		nu.UUID, nu.Valid = uuid.Nil, false
-		return errors.New("scan uuid is failed")
+		return parseError("NullUUID", value, err)
+	}
 
+	if err := validateUUID(res); err != nil {
+		nu.UUID, nu.Valid = uuid.Nil, false
+		return NewScanError("NullUUID", value, err)
 	}
 
-	nu.Valid = true
+	nu.UUID, nu.Valid = res, true
 
 	return nil
 }
@@ -51,7 +181,7 @@ func (nu NullUUID) MarshalJSON() ([]byte, error) {
 		return NullType, nil
 	}
 
-	return json.Marshal(nu.UUID)
+	return json.Marshal(FormatUUID(nu.UUID, UUIDJSONFormat))
 }
 
 // UnmarshalJSON method
@@ -61,12 +191,93 @@ func (nu *NullUUID) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	err := json.Unmarshal(data, &nu.UUID)
+	var res string
+
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		return err
+	}
+
+	id, err := ParseUUID(res)
+	if err != nil {
+		return err
+	}
+
+	err = validateUUID(id)
+	if err != nil {
+		return err
+	}
+
+	*nu = NullUUID{UUID: id, Valid: true}
+
+	return nil
+}
+
+// MarshalText method
+func (nu NullUUID) MarshalText() ([]byte, error) {
+
+	if !nu.Valid {
+		return NullText, nil
+	}
+
+	return []byte(FormatUUID(nu.UUID, UUIDJSONFormat)), nil
+}
+
+// UnmarshalText method
+func (nu *NullUUID) UnmarshalText(text []byte) error {
+	if bytes.Equal(text, NullText) {
+		*nu = NullUUID{}
+		return nil
+	}
+
+	res, err := ParseUUID(string(text))
+	if err != nil {
+		return err
+	}
+
+	err = validateUUID(res)
 	if err != nil {
 		return err
 	}
 
-	nu.Valid = true
+	*nu = NullUUID{UUID: res, Valid: true}
 
 	return nil
 }
+
+// Version returns the version of the UUID, or 0 if it is null.
+func (nu NullUUID) Version() uuid.Version {
+	if !nu.Valid {
+		return 0
+	}
+
+	return nu.UUID.Version()
+}
+
+// Timestamp returns the creation time embedded in a version 1, 6 or 7 UUID,
+// in TimeLocation. It is invalid for null UUIDs and other versions.
+func (nu NullUUID) Timestamp() NullTime {
+	if !nu.Valid {
+		return NullTime{}
+	}
+
+	u := nu.UUID
+
+	var ts uuid.Time
+
+	switch u.Version() {
+	case 1, 7:
+		ts = u.Time()
+
+	case 6:
+		// uuid.UUID.Time reads version 6 as if it were unshuffled.
+		ts = uuid.Time(binary.BigEndian.Uint64(u[:8])>>16<<12 | uint64(binary.BigEndian.Uint16(u[6:8])&0x0fff))
+
+	default:
+		return NullTime{}
+	}
+
+	sec, nsec := ts.UnixTime()
+
+	return NullTime{Time: time.Unix(sec, nsec).In(TimeLocation), Valid: true}
+}
@@ -0,0 +1,147 @@
+package nullish
+
+import (
+	"database/sql/driver"
+
+	"github.com/google/uuid"
+)
+
+// NullUUIDBinary is a nullable uuid.UUID stored as 16 raw bytes, as in MySQL
+// BINARY(16) columns.
+//
+// Value writes the 16 bytes and Scan accepts 16 raw bytes, falling back to
+// string or []byte in any UUIDFormat. JSON and text are the same as
+// NullUUID.
+type NullUUIDBinary struct {
+	UUID  uuid.UUID
+	Valid bool
+
+	// Swapped stores the bytes in the time-swapped order of MySQL
+	// UUID_TO_BIN(x, 1) and BIN_TO_UUID(x, 1), which keeps version 1 UUIDs
+	// in index order. It is kept across Scan and Unmarshal.
+	Swapped bool
+}
+
+// Value method
+func (nu NullUUIDBinary) Value() (driver.Value, error) {
+
+	if !nu.Valid {
+		return nil, nil
+	}
+
+	if nu.Swapped {
+		return swapUUIDTime(nu.UUID), nil
+	}
+
+	return nu.UUID[:], nil
+}
+
+// Scan method
+func (nu *NullUUIDBinary) Scan(value interface{}) error {
+
+	if value == nil {
+		nu.UUID, nu.Valid = uuid.Nil, false
+		return nil
+	}
+
+	var (
+		res uuid.UUID
+		err error
+	)
+
+	switch t := value.(type) {
+	case string:
+		res, err = ParseUUID(t)
+
+	case []byte:
+		if len(t) == 16 {
+			res = uuid.UUID(t)
+			if nu.Swapped {
+				res = unswapUUIDTime(t)
+			}
+			break
+		}
+		res, err = ParseUUID(string(t))
+
+	default:
+		nu.UUID, nu.Valid = uuid.Nil, false
+		return unsupportedSourceError("NullUUIDBinary", value)
+	}
+
+	if err != nil {
+		nu.UUID, nu.Valid = uuid.Nil, false
+		return parseError("NullUUIDBinary", value, err)
+	}
+
+	if err := validateUUID(res); err != nil {
+		nu.UUID, nu.Valid = uuid.Nil, false
+		return NewScanError("NullUUIDBinary", value, err)
+	}
+
+	nu.UUID, nu.Valid = res, true
+
+	return nil
+}
+
+// MarshalJSON method
+func (nu NullUUIDBinary) MarshalJSON() ([]byte, error) {
+	return NullUUID{UUID: nu.UUID, Valid: nu.Valid}.MarshalJSON()
+}
+
+// UnmarshalJSON method
+func (nu *NullUUIDBinary) UnmarshalJSON(data []byte) error {
+	var res NullUUID
+
+	err := res.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	nu.UUID, nu.Valid = res.UUID, res.Valid
+
+	return nil
+}
+
+// MarshalText method
+func (nu NullUUIDBinary) MarshalText() ([]byte, error) {
+	return NullUUID{UUID: nu.UUID, Valid: nu.Valid}.MarshalText()
+}
+
+// UnmarshalText method
+func (nu *NullUUIDBinary) UnmarshalText(text []byte) error {
+	var res NullUUID
+
+	err := res.UnmarshalText(text)
+	if err != nil {
+		return err
+	}
+
+	nu.UUID, nu.Valid = res.UUID, res.Valid
+
+	return nil
+}
+
+// swapUUIDTime moves time_hi and time_mid in front of time_low, like
+// UUID_TO_BIN(x, 1).
+func swapUUIDTime(u uuid.UUID) []byte {
+	b := make([]byte, 16)
+
+	copy(b[0:2], u[6:8])
+	copy(b[2:4], u[4:6])
+	copy(b[4:8], u[0:4])
+	copy(b[8:], u[8:])
+
+	return b
+}
+
+// unswapUUIDTime reverses swapUUIDTime, like BIN_TO_UUID(x, 1).
+func unswapUUIDTime(b []byte) uuid.UUID {
+	var u uuid.UUID
+
+	copy(u[0:4], b[4:8])
+	copy(u[4:6], b[2:4])
+	copy(u[6:8], b[0:2])
+	copy(u[8:], b[8:])
+
+	return u
+}
@@ -0,0 +1,132 @@
+package nullish
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+)
+
+// fixtureUUIDv1 with its BINARY(16) forms from MySQL UUID_TO_BIN(x) and
+// UUID_TO_BIN(x, 1).
+var (
+	fixtureUUIDv1      = uuid.MustParse("6ccd780c-baba-1026-9564-5b8c656024db")
+	fixtureUUIDv1Bytes = []byte{0x6c, 0xcd, 0x78, 0x0c, 0xba, 0xba, 0x10, 0x26, 0x95, 0x64, 0x5b, 0x8c, 0x65, 0x60, 0x24, 0xdb}
+	fixtureUUIDv1Swap  = []byte{0x10, 0x26, 0xba, 0xba, 0x6c, 0xcd, 0x78, 0x0c, 0x95, 0x64, 0x5b, 0x8c, 0x65, 0x60, 0x24, 0xdb}
+)
+
+func TestNullUUIDBinary_Value(t *testing.T) {
+	nu := NewNullUUIDBinary(fixtureUUIDv1, true)
+
+	val, err := nu.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b, ok := val.([]byte); !ok || !bytes.Equal(b, fixtureUUIDv1Bytes) {
+		t.Errorf("expected %x, got %v", fixtureUUIDv1Bytes, val)
+	}
+
+	nu.Swapped = true
+
+	val, err = nu.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b, ok := val.([]byte); !ok || !bytes.Equal(b, fixtureUUIDv1Swap) {
+		t.Errorf("expected %x, got %v", fixtureUUIDv1Swap, val)
+	}
+
+	// Value must not expose the UUID array to the driver
+	if b := val.([]byte); &b[0] == &nu.UUID[0] {
+		t.Error("expected a copy of the UUID bytes")
+	}
+
+	val, err = NullUUIDBinary{}.Value()
+	if err != nil || val != nil {
+		t.Errorf("expected nil, got %v err=%v", val, err)
+	}
+}
+
+func TestNullUUIDBinary_Scan(t *testing.T) {
+	var nu NullUUIDBinary
+
+	if err := nu.Scan(fixtureUUIDv1Bytes); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if nu.UUID != fixtureUUIDv1 || !nu.Valid {
+		t.Errorf("expected %s, got %+v", fixtureUUIDv1, nu)
+	}
+
+	swapped := NullUUIDBinary{Swapped: true}
+	if err := swapped.Scan(fixtureUUIDv1Swap); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if swapped.UUID != fixtureUUIDv1 || !swapped.Valid || !swapped.Swapped {
+		t.Errorf("expected swapped %s, got %+v", fixtureUUIDv1, swapped)
+	}
+
+	// Roundtrip through Value
+	val, _ := swapped.Value()
+	back := NullUUIDBinary{Swapped: true}
+	if err := back.Scan(val); err != nil || back != swapped {
+		t.Errorf("expected roundtrip, got %+v err=%v", back, err)
+	}
+
+	// Text forms are accepted and never swapped
+	if err := swapped.Scan("6ccd780c-baba-1026-9564-5b8c656024db"); err != nil || swapped.UUID != fixtureUUIDv1 {
+		t.Errorf("expected text to scan, got %+v err=%v", swapped, err)
+	}
+
+	if err := swapped.Scan(nil); err != nil || swapped.Valid || !swapped.Swapped {
+		t.Errorf("expected null keeping Swapped, got %+v err=%v", swapped, err)
+	}
+
+	if err := nu.Scan([]byte{1, 2, 3}); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+	if nu.Valid {
+		t.Error("expected invalid after failed scan")
+	}
+
+	if err := nu.Scan(42); !errors.Is(err, ErrUnsupportedSource) {
+		t.Errorf("expected unsupported type error, got %v", err)
+	}
+}
+
+func TestNullUUIDBinary_JSON(t *testing.T) {
+	nu := NullUUIDBinary{UUID: fixtureUUIDv1, Valid: true, Swapped: true}
+
+	data, err := json.Marshal(nu)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `"6ccd780c-baba-1026-9564-5b8c656024db"` {
+		t.Errorf("expected canonical string, got %s", data)
+	}
+
+	decoded := NullUUIDBinary{Swapped: true}
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != nu {
+		t.Errorf("expected %+v, got %+v err=%v", nu, decoded, err)
+	}
+
+	if err := json.Unmarshal([]byte("null"), &decoded); err != nil || decoded.Valid || !decoded.Swapped {
+		t.Errorf("expected null keeping Swapped, got %+v err=%v", decoded, err)
+	}
+
+	data, err = json.Marshal(NullUUIDBinary{})
+	if err != nil || string(data) != "null" {
+		t.Errorf("expected null, got %s err=%v", data, err)
+	}
+
+	text, err := nu.MarshalText()
+	if err != nil || string(text) != fixtureUUIDv1.String() {
+		t.Errorf("expected %s, got %s err=%v", fixtureUUIDv1, text, err)
+	}
+
+	var fromText NullUUIDBinary
+	if err := fromText.UnmarshalText(text); err != nil || fromText.UUID != fixtureUUIDv1 || !fromText.Valid {
+		t.Errorf("expected %s, got %+v err=%v", fixtureUUIDv1, fromText, err)
+	}
+}
@@ -1,7 +1,9 @@
 ﻿package nullish
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/goccy/go-json"
 	"github.com/google/uuid"
@@ -138,6 +140,207 @@ func TestNullUUID_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestParseUUID(t *testing.T) {
+	want := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	forms := []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"a6e4EJ2tEdGAtADAT9QwyA==",
+		"a6e4EJ2tEdGAtADAT9QwyA",
+		" 6ba7b810-9dad-11d1-80b4-00c04fd430c8 ",
+	}
+
+	for _, in := range forms {
+		got, err := ParseUUID(in)
+		if err != nil {
+			t.Fatalf("ParseUUID(%q): unexpected error: %v", in, err)
+		}
+		if got != want {
+			t.Errorf("ParseUUID(%q): expected %s, got %s", in, want, got)
+		}
+	}
+
+	// URL-safe alphabet
+	urlSafe := uuid.MustParse("fbffbffb-0000-4000-8000-000000000000")
+	for _, in := range []string{"-_-_-wAAQACAAAAAAAAAAA", "-_-_-wAAQACAAAAAAAAAAA=="} {
+		got, err := ParseUUID(in)
+		if err != nil || got != urlSafe {
+			t.Errorf("ParseUUID(%q): expected %s, got %s err=%v", in, urlSafe, got, err)
+		}
+	}
+
+	for _, in := range []string{"", "not-a-uuid", "a6e4EJ2tEdGAtADAT9Qw", "!!!!!!!!!!!!!!!!!!!!!!"} {
+		if _, err := ParseUUID(in); err == nil {
+			t.Errorf("ParseUUID(%q): expected error", in)
+		}
+	}
+}
+
+func TestNullUUID_Formats(t *testing.T) {
+	defer func(format UUIDFormat) { UUIDJSONFormat = format }(UUIDJSONFormat)
+
+	id := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	nu := NewNullUUID(id, true)
+
+	tests := []struct {
+		format UUIDFormat
+		want   string
+	}{
+		{UUIDFormatCanonical, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{UUIDFormatCompact, "6ba7b8109dad11d180b400c04fd430c8"},
+		{UUIDFormatURN, "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{UUIDFormatBraced, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"},
+		{UUIDFormatBase64, "a6e4EJ2tEdGAtADAT9QwyA=="},
+		{UUIDFormatBase64URL, "a6e4EJ2tEdGAtADAT9QwyA"},
+	}
+
+	for _, tt := range tests {
+		UUIDJSONFormat = tt.format
+
+		data, err := json.Marshal(nu)
+		if err != nil {
+			t.Fatalf("marshal error: %v", err)
+		}
+		if string(data) != `"`+tt.want+`"` {
+			t.Errorf("format %d: expected %q, got %s", tt.format, tt.want, data)
+		}
+
+		text, err := nu.MarshalText()
+		if err != nil || string(text) != tt.want {
+			t.Errorf("format %d: expected %s, got %s err=%v", tt.format, tt.want, text, err)
+		}
+
+		var decoded NullUUID
+		if err := json.Unmarshal(data, &decoded); err != nil || decoded != nu {
+			t.Errorf("format %d: roundtrip failed, got %+v err=%v", tt.format, decoded, err)
+		}
+
+		if err := decoded.Scan(tt.want); err != nil || decoded != nu {
+			t.Errorf("format %d: scan failed, got %+v err=%v", tt.format, decoded, err)
+		}
+
+		// Value always writes the canonical form
+		got, err := nu.Value()
+		if err != nil || got != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+			t.Errorf("format %d: expected canonical value, got %v err=%v", tt.format, got, err)
+		}
+	}
+
+	var decoded NullUUID
+	if err := decoded.Scan(id[:]); err != nil || decoded != nu {
+		t.Errorf("expected raw bytes to scan, got %+v err=%v", decoded, err)
+	}
+	if err := decoded.Scan([]byte("{6ba7b810-9dad-11d1-80b4-00c04fd430c8}")); err != nil || decoded != nu {
+		t.Errorf("expected braced bytes to scan, got %+v err=%v", decoded, err)
+	}
+	if err := decoded.Scan("{6ba7b810}"); !errors.Is(err, ErrParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`"nope"`), &decoded); err == nil {
+		t.Error("expected error for invalid UUID")
+	}
+}
+
+func TestNullUUID_Versions(t *testing.T) {
+	v4 := NewNullUUIDv4()
+	if !v4.Valid || v4.Version() != 4 {
+		t.Errorf("expected valid version 4, got %+v", v4)
+	}
+
+	v7 := NewNullUUIDv7()
+	if !v7.Valid || v7.Version() != 7 {
+		t.Errorf("expected valid version 7, got %+v", v7)
+	}
+
+	if ts := v7.Timestamp(); !ts.Valid || time.Since(ts.Time) > time.Minute || time.Since(ts.Time) < -time.Minute {
+		t.Errorf("expected a recent timestamp, got %+v", ts)
+	}
+
+	if NewNullUUIDv7() == v7 {
+		t.Error("expected distinct UUIDs")
+	}
+
+	if v := (NullUUID{UUID: v4.UUID}).Version(); v != 0 {
+		t.Errorf("expected version 0 for null, got %d", v)
+	}
+}
+
+func TestNullUUID_Timestamp(t *testing.T) {
+	// Test vectors of RFC 9562, all created at 2022-02-22T19:22:22Z
+	want := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+
+	for _, s := range []string{
+		"c232ab00-9414-11ec-b3c8-9f6bdeced846",
+		"1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+		"017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+	} {
+		ts := NewNullUUID(uuid.MustParse(s), true).Timestamp()
+		if !ts.Valid || !ts.Time.Equal(want) {
+			t.Errorf("%s: expected %v, got %+v", s, want, ts)
+		}
+	}
+
+	if ts := NewNullUUIDv4().Timestamp(); ts.Valid {
+		t.Errorf("expected invalid timestamp for version 4, got %+v", ts)
+	}
+
+	if ts := (NullUUID{}).Timestamp(); ts.Valid {
+		t.Errorf("expected invalid timestamp for null, got %+v", ts)
+	}
+}
+
+func TestNullUUID_Validator(t *testing.T) {
+	defer func(v func(uuid.UUID) error) { UUIDValidator = v }(UUIDValidator)
+	UUIDValidator = AllowUUIDVersions(4, 7)
+
+	v1 := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	v7 := NewNullUUIDv7()
+
+	var nu NullUUID
+	if err := nu.Scan(v1); !errors.Is(err, ErrUUIDVersion) {
+		t.Errorf("expected version error, got %v", err)
+	}
+	var se *ScanError
+	if err := nu.Scan(v1); !errors.As(err, &se) || se.Target != "NullUUID" {
+		t.Errorf("expected ScanError for NullUUID, got %v", err)
+	}
+	if nu.Valid {
+		t.Error("expected invalid after rejected scan")
+	}
+
+	if err := json.Unmarshal([]byte(`"`+v1+`"`), &nu); !errors.Is(err, ErrUUIDVersion) {
+		t.Errorf("expected version error, got %v", err)
+	}
+	if err := nu.UnmarshalText([]byte(v1)); !errors.Is(err, ErrUUIDVersion) {
+		t.Errorf("expected version error, got %v", err)
+	}
+
+	var nb NullUUIDBinary
+	id := uuid.MustParse(v1)
+	if err := nb.Scan(id[:]); !errors.Is(err, ErrUUIDVersion) {
+		t.Errorf("expected version error, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`"`+v1+`"`), &nb); !errors.Is(err, ErrUUIDVersion) {
+		t.Errorf("expected version error, got %v", err)
+	}
+
+	if err := nu.Scan(v7.UUID.String()); err != nil || nu != v7 {
+		t.Errorf("expected %+v, got %+v err=%v", v7, nu, err)
+	}
+
+	// Null is never validated
+	if err := nu.Scan(nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := json.Unmarshal([]byte("null"), &nu); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
 func BenchmarkNullUUID_Value(b *testing.B) {
 	nu := NewNullUUID(uuid.New(), true)
 	for i := 0; i < b.N; i++ {